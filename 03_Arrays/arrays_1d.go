@@ -0,0 +1,53 @@
+package arrays
+
+import "fmt"
+
+// TraverseArray prints every element of a 1D array
+func TraverseArray(arr []int) {
+	for _, value := range arr {
+		fmt.Print(value, " ")
+	}
+	fmt.Println()
+}
+
+// FindMax returns the maximum element in a 1D array
+func FindMax(arr []int) int {
+	max := arr[0]
+	for _, value := range arr {
+		if value > max {
+			max = value
+		}
+	}
+	return max
+}
+
+// TwoSum uses the two-pointer technique to find a pair with a given sum
+func TwoSum(arr []int, target int) (int, int) {
+	left, right := 0, len(arr)-1
+	for left < right {
+		sum := arr[left] + arr[right]
+		if sum == target {
+			return left, right
+		} else if sum < target {
+			left++
+		} else {
+			right--
+		}
+	}
+	return -1, -1
+}
+
+// MaxSumSubarray uses the sliding window technique to find the maximum sum of a subarray of size k
+func MaxSumSubarray(arr []int, k int) int {
+	maxSum, windowSum := 0, 0
+	for i := 0; i < len(arr); i++ {
+		windowSum += arr[i]
+		if i >= k-1 {
+			if windowSum > maxSum {
+				maxSum = windowSum
+			}
+			windowSum -= arr[i-(k-1)]
+		}
+	}
+	return maxSum
+}
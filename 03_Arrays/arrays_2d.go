@@ -0,0 +1,25 @@
+package arrays
+
+import "fmt"
+
+// Traverse2DArray prints every row of a 2D array
+func Traverse2DArray(matrix [][]int) {
+	for _, row := range matrix {
+		for _, value := range row {
+			fmt.Print(value, " ")
+		}
+		fmt.Println()
+	}
+}
+
+// AddMatrices performs matrix addition
+func AddMatrices(a, b [][]int) [][]int {
+	result := make([][]int, len(a))
+	for i := range a {
+		result[i] = make([]int, len(a[i]))
+		for j := range a[i] {
+			result[i][j] = a[i][j] + b[i][j]
+		}
+	}
+	return result
+}
@@ -0,0 +1,16 @@
+package arrays
+
+import "fmt"
+
+// Traverse3DArray prints every matrix of a 3D array
+func Traverse3DArray(cube [][][]int) {
+	for _, matrix := range cube {
+		for _, row := range matrix {
+			for _, value := range row {
+				fmt.Print(value, " ")
+			}
+			fmt.Println()
+		}
+		fmt.Println()
+	}
+}
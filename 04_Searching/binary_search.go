@@ -1,9 +1,9 @@
-package main
+package search
 
-import "fmt"
+import "cmp"
 
-// Binary search function
-func binarySearch(arr []int, target int) int {
+// BinarySearch returns the index of target in the sorted slice arr, or -1 if it is not found
+func BinarySearch[T cmp.Ordered](arr []T, target T) int {
 	low, high := 0, len(arr)-1
 	for low <= high {
 		mid := (low + high) / 2
@@ -17,10 +17,3 @@ func binarySearch(arr []int, target int) int {
 	}
 	return -1 // Return -1 if the target is not found
 }
-
-func main() {
-	arr := []int{1, 2, 3, 4, 5}
-	target := 3
-	index := binarySearch(arr, target)
-	fmt.Println("Index of", target, ":", index)
-}
@@ -0,0 +1,31 @@
+package search
+
+// Integer is the set of types InterpolationSearch can estimate positions for
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// InterpolationSearch returns the index of target in the sorted, uniformly
+// distributed slice arr, or -1 if it is not found
+func InterpolationSearch[T Integer](arr []T, target T) int {
+	low, high := 0, len(arr)-1
+	for low <= high && target >= arr[low] && target <= arr[high] {
+		if low == high {
+			if arr[low] == target {
+				return low
+			}
+			return -1
+		}
+		pos := low + int(float64(high-low)/(float64(arr[high])-float64(arr[low]))*(float64(target)-float64(arr[low])))
+		if arr[pos] == target {
+			return pos
+		}
+		if arr[pos] < target {
+			low = pos + 1
+		} else {
+			high = pos - 1
+		}
+	}
+	return -1
+}
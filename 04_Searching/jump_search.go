@@ -1,13 +1,16 @@
-package main
+package search
 
 import (
-	"fmt"
+	"cmp"
 	"math"
 )
 
-// Jump search function
-func jumpSearch(arr []int, target int) int {
+// JumpSearch returns the index of target in the sorted slice arr, or -1 if it is not found
+func JumpSearch[T cmp.Ordered](arr []T, target T) int {
 	n := len(arr)
+	if n == 0 {
+		return -1
+	}
 	step := int(math.Sqrt(float64(n)))
 	prev := 0
 	for arr[min(step, n)-1] < target {
@@ -28,16 +31,3 @@ func jumpSearch(arr []int, target int) int {
 	}
 	return -1
 }
-
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
-
-func main() {
-	arr := []int{1, 2, 3, 4, 5}
-	target := 3
-	fmt.Println("Jump Search Index:", jumpSearch(arr, target))
-}
@@ -0,0 +1,11 @@
+package search
+
+// LinearSearch returns the index of target in arr, or -1 if it is not found
+func LinearSearch[T comparable](arr []T, target T) int {
+	for i, v := range arr {
+		if v == target {
+			return i
+		}
+	}
+	return -1 // Return -1 if the target is not found
+}
@@ -0,0 +1,16 @@
+package sorting
+
+import "cmp"
+
+// BubbleSort sorts arr in place and returns it
+func BubbleSort[T cmp.Ordered](arr []T) []T {
+	n := len(arr)
+	for i := 0; i < n-1; i++ {
+		for j := 0; j < n-i-1; j++ {
+			if arr[j] > arr[j+1] {
+				arr[j], arr[j+1] = arr[j+1], arr[j]
+			}
+		}
+	}
+	return arr
+}
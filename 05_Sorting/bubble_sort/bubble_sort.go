@@ -1,21 +0,0 @@
-package main
-
-import "fmt"
-
-// Bubble Sort
-func bubbleSort(arr []int) []int {
-	n := len(arr)
-	for i := 0; i < n-1; i++ {
-		for j := 0; j < n-i-1; j++ {
-			if arr[j] > arr[j+1] {
-				arr[j], arr[j+1] = arr[j+1], arr[j]
-			}
-		}
-	}
-	return arr
-}
-
-func main() {
-	arr := []int{64, 25, 12, 22, 11}
-	fmt.Println("Bubble Sorted:", bubbleSort(append([]int{}, arr...)))
-}
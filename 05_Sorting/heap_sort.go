@@ -0,0 +1,37 @@
+package sorting
+
+import "cmp"
+
+// HeapSort sorts arr in place using a max-heap and returns it
+func HeapSort[T cmp.Ordered](arr []T) []T {
+	n := len(arr)
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDown(arr, n, i)
+	}
+	for i := n - 1; i > 0; i-- {
+		arr[0], arr[i] = arr[i], arr[0]
+		siftDown(arr, i, 0)
+	}
+	return arr
+}
+
+// siftDown moves arr[i] towards the leaves of the max-heap arr[:n] until
+// neither child is larger than it
+func siftDown[T cmp.Ordered](arr []T, n int, i int) {
+	for {
+		largest := i
+		left := 2*i + 1
+		right := 2*i + 2
+		if left < n && arr[left] > arr[largest] {
+			largest = left
+		}
+		if right < n && arr[right] > arr[largest] {
+			largest = right
+		}
+		if largest == i {
+			return
+		}
+		arr[i], arr[largest] = arr[largest], arr[i]
+		i = largest
+	}
+}
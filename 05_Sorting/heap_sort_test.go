@@ -0,0 +1,34 @@
+package sorting
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestHeapSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 16, 1000} {
+		arr := make([]int, n)
+		for i := range arr {
+			arr[i] = rng.Intn(n + 1)
+		}
+		want := slices.Clone(arr)
+		slices.Sort(want)
+		if got := HeapSort(arr); !slices.Equal(got, want) || !slices.Equal(arr, want) {
+			t.Fatalf("HeapSort of %d elements = %v, want %v", n, got, want)
+		}
+	}
+	// sorted, reversed and constant input each take a different path
+	// through the sift-down
+	for _, arr := range [][]int{{1, 2, 3, 4, 5, 6}, {6, 5, 4, 3, 2, 1}, {7, 7, 7, 7}} {
+		want := slices.Sorted(slices.Values(arr))
+		if got := HeapSort(slices.Clone(arr)); !slices.Equal(got, want) {
+			t.Fatalf("HeapSort(%v) = %v, want %v", arr, got, want)
+		}
+	}
+	words := []string{"pear", "apple", "fig", "apple"}
+	if got := HeapSort(words); !slices.Equal(got, []string{"apple", "apple", "fig", "pear"}) {
+		t.Fatalf("HeapSort(words) = %v", got)
+	}
+}
@@ -0,0 +1,18 @@
+package sorting
+
+import "cmp"
+
+// InsertionSort sorts arr in place and returns it
+func InsertionSort[T cmp.Ordered](arr []T) []T {
+	n := len(arr)
+	for i := 1; i < n; i++ {
+		key := arr[i]
+		j := i - 1
+		for j >= 0 && arr[j] > key {
+			arr[j+1] = arr[j]
+			j = j - 1
+		}
+		arr[j+1] = key
+	}
+	return arr
+}
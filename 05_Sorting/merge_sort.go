@@ -1,20 +1,20 @@
-package main
+package sorting
 
-import "fmt"
+import "cmp"
 
-// Merge Sort
-func mergeSort(arr []int) []int {
+// MergeSort returns a sorted copy of arr
+func MergeSort[T cmp.Ordered](arr []T) []T {
 	if len(arr) <= 1 {
 		return arr
 	}
 	mid := len(arr) / 2
-	left := mergeSort(arr[:mid])
-	right := mergeSort(arr[mid:])
+	left := MergeSort(arr[:mid])
+	right := MergeSort(arr[mid:])
 	return merge(left, right)
 }
 
-func merge(left, right []int) []int {
-	merged := []int{}
+func merge[T cmp.Ordered](left, right []T) []T {
+	merged := []T{}
 	for len(left) > 0 && len(right) > 0 {
 		if left[0] <= right[0] {
 			merged = append(merged, left[0])
@@ -28,8 +28,3 @@ func merge(left, right []int) []int {
 	merged = append(merged, right...)
 	return merged
 }
-
-func main() {
-	arr := []int{64, 25, 12, 22, 11}
-	fmt.Println("Merge Sorted:", mergeSort(append([]int{}, arr...)))
-}
@@ -1,12 +1,12 @@
-package main
+package sorting
 
 import (
-	"fmt"
+	"cmp"
 	"math/rand"
 )
 
-// Quick Sort
-func quickSort(arr []int) []int {
+// QuickSort sorts arr in place around random pivots and returns it
+func QuickSort[T cmp.Ordered](arr []T) []T {
 	if len(arr) < 2 {
 		return arr
 	}
@@ -20,12 +20,7 @@ func quickSort(arr []int) []int {
 		}
 	}
 	arr[left], arr[right] = arr[right], arr[left]
-	quickSort(arr[:left])
-	quickSort(arr[left+1:])
+	QuickSort(arr[:left])
+	QuickSort(arr[left+1:])
 	return arr
 }
-
-func main() {
-	arr := []int{64, 25, 12, 22, 11}
-	fmt.Println("Quick Sorted:", quickSort(append([]int{}, arr...)))
-}
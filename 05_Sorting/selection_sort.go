@@ -1,9 +1,9 @@
-package main
+package sorting
 
-import "fmt"
+import "cmp"
 
-// Selection Sort
-func selectionSort(arr []int) []int {
+// SelectionSort sorts arr in place and returns it
+func SelectionSort[T cmp.Ordered](arr []T) []T {
 	n := len(arr)
 	for i := 0; i < n-1; i++ {
 		minIndex := i
@@ -16,8 +16,3 @@ func selectionSort(arr []int) []int {
 	}
 	return arr
 }
-
-func main() {
-	arr := []int{64, 25, 12, 22, 11}
-	fmt.Println("Selection Sorted:", selectionSort(append([]int{}, arr...)))
-}
@@ -1,25 +1,25 @@
-package main
+package recursion
 
 import "fmt"
 
-// Factorial using recursion
-func factorial(n int) int {
+// Factorial computes n! using recursion
+func Factorial(n int) int {
 	if n == 0 {
 		return 1
 	}
-	return n * factorial(n-1)
+	return n * Factorial(n-1)
 }
 
-// Fibonacci using recursion
-func fibonacci(n int) int {
+// Fibonacci returns the nth Fibonacci number using recursion
+func Fibonacci(n int) int {
 	if n <= 1 {
 		return n
 	}
-	return fibonacci(n-1) + fibonacci(n-2)
+	return Fibonacci(n-1) + Fibonacci(n-2)
 }
 
-// N-Queens problem using backtracking
-func solveNQueens(n int) [][]string {
+// SolveNQueens solves the N-Queens problem using backtracking
+func SolveNQueens(n int) [][]string {
 	var result [][]string
 	var board [][]string
 	for i := 0; i < n; i++ {
@@ -68,9 +68,3 @@ func isValid(board [][]string, row, col, n int) bool {
 	}
 	return true
 }
-
-func main() {
-	fmt.Println("Factorial of 5:", factorial(5))
-	fmt.Println("Fibonacci of 5:", fibonacci(5))
-	fmt.Println("N-Queens solutions for 4 queens:", solveNQueens(4))
-}
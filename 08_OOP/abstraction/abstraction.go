@@ -33,15 +33,3 @@ func (b Bike) Start() string {
 func (b Bike) Stop() string {
 	return fmt.Sprintf("%s is stopping", b.Model)
 }
-
-func main() {
-	var v Vehicle
-
-	v = Car{Model: "Toyota"}
-	fmt.Println(v.Start())
-	fmt.Println(v.Stop())
-
-	v = Bike{Model: "Yamaha"}
-	fmt.Println(v.Start())
-	fmt.Println(v.Stop())
-}
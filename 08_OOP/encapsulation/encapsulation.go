@@ -1,7 +1,5 @@
 package encapsulation
 
-import "fmt"
-
 // BankAccount struct demonstrating encapsulation
 type BankAccount struct {
 	balance       float64 // Public field
@@ -31,10 +29,3 @@ func (b *BankAccount) Withdraw(amount float64) {
 func (b *BankAccount) GetBalance() float64 {
 	return b.balance
 }
-
-func main() {
-	account := NewBankAccount("123456789", 1000.0)
-	account.Deposit(500.0)
-	account.Withdraw(200.0)
-	fmt.Println("Current Balance:", account.GetBalance())
-}
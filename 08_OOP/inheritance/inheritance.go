@@ -1,7 +1,5 @@
 package inheritance
 
-import "fmt"
-
 // Person struct
 type Person struct {
 	Name string
@@ -13,13 +11,3 @@ type Employee struct {
 	Person
 	Department string
 }
-
-func main() {
-	emp := Employee{
-		Person:     Person{Name: "John Doe", Age: 30},
-		Department: "Engineering",
-	}
-	fmt.Println("Employee Name:", emp.Name)
-	fmt.Println("Employee Age:", emp.Age)
-	fmt.Println("Employee Department:", emp.Department)
-}
@@ -1,9 +1,6 @@
 package polymorphism
 
-import (
-	"fmt"
-	"math"
-)
+import "math"
 
 // Shape interface demonstrating polymorphism
 type Shape interface {
@@ -36,15 +33,3 @@ func (r Rectangle) Area() float64 {
 func (r Rectangle) Perimeter() float64 {
 	return 2 * (r.Width + r.Height)
 }
-
-func main() {
-	var s Shape
-
-	s = Circle{Radius: 5}
-	fmt.Println("Circle Area:", s.Area())
-	fmt.Println("Circle Perimeter:", s.Perimeter())
-
-	s = Rectangle{Width: 4, Height: 6}
-	fmt.Println("Rectangle Area:", s.Area())
-	fmt.Println("Rectangle Perimeter:", s.Perimeter())
-}
@@ -0,0 +1,171 @@
+package list
+
+import "cmp"
+
+// FromHead wraps an existing acyclic chain of nodes in a SinglyLinkedList,
+// recomputing Tail and the element count. Use it to get back to a list after
+// running the node-level algorithms below.
+func FromHead[T any](head *Node[T]) *SinglyLinkedList[T] {
+	sll := &SinglyLinkedList[T]{Head: head}
+	for current := head; current != nil; current = current.Next {
+		sll.Tail = current
+		sll.size++
+	}
+	return sll
+}
+
+// DetectCycle uses Floyd's fast and slow pointers to find the node where a
+// cycle begins, or nil if the chain starting at head is acyclic
+func DetectCycle[T any](head *Node[T]) *Node[T] {
+	slow, fast := head, head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			// The distance from head to the entry equals the distance from
+			// the meeting point to the entry, going around the cycle
+			for entry := head; ; entry, slow = entry.Next, slow.Next {
+				if entry == slow {
+					return entry
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// MiddleNode returns the middle node of the chain, or the second of the two
+// middle nodes when the length is even
+func MiddleNode[T any](head *Node[T]) *Node[T] {
+	slow, fast := head, head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+	return slow
+}
+
+// MergeSorted splices two sorted chains into one sorted chain and returns its
+// head. Equal elements keep a's before b's; no nodes are allocated.
+func MergeSorted[T cmp.Ordered](a, b *Node[T]) *Node[T] {
+	dummy := &Node[T]{}
+	tail := dummy
+	for a != nil && b != nil {
+		if a.Data <= b.Data {
+			tail.Next, a = a, a.Next
+		} else {
+			tail.Next, b = b, b.Next
+		}
+		tail = tail.Next
+	}
+	if a != nil {
+		tail.Next = a
+	} else {
+		tail.Next = b
+	}
+	return dummy.Next
+}
+
+// MergeKSorted merges any number of sorted chains by merging them in pairs,
+// which takes O(n log k) time for n nodes spread over k chains
+func MergeKSorted[T cmp.Ordered](heads []*Node[T]) *Node[T] {
+	if len(heads) == 0 {
+		return nil
+	}
+	heads = append([]*Node[T]{}, heads...)
+	for len(heads) > 1 {
+		merged := heads[:0]
+		for i := 0; i < len(heads); i += 2 {
+			if i+1 < len(heads) {
+				merged = append(merged, MergeSorted(heads[i], heads[i+1]))
+			} else {
+				merged = append(merged, heads[i])
+			}
+		}
+		heads = merged
+	}
+	return heads[0]
+}
+
+// IntersectionNode returns the first node shared by two acyclic chains, or nil
+// if they never meet. Each pointer switches to the other chain when it runs
+// out, so both have walked the same distance when they reach the intersection.
+func IntersectionNode[T any](a, b *Node[T]) *Node[T] {
+	if a == nil || b == nil {
+		return nil
+	}
+	p, q := a, b
+	for p != q {
+		if p == nil {
+			p = b
+		} else {
+			p = p.Next
+		}
+		if q == nil {
+			q = a
+		} else {
+			q = q.Next
+		}
+	}
+	return p
+}
+
+// RemoveNthFromEnd unlinks the nth node counting from the end (1 is the last
+// node) and returns the new head. The chain is returned unchanged if n is out
+// of range.
+func RemoveNthFromEnd[T any](head *Node[T], n int) *Node[T] {
+	if n < 1 {
+		return head
+	}
+	dummy := &Node[T]{Next: head}
+	lead := dummy
+	for i := 0; i < n; i++ {
+		lead = lead.Next
+		if lead == nil {
+			return head
+		}
+	}
+	trail := dummy
+	for lead.Next != nil {
+		lead = lead.Next
+		trail = trail.Next
+	}
+	trail.Next = trail.Next.Next
+	return dummy.Next
+}
+
+// IsPalindrome reports whether the chain reads the same in both directions.
+// It reverses the second half in place to compare in O(1) extra space and
+// restores it before returning.
+func IsPalindrome[T comparable](head *Node[T]) bool {
+	if head == nil || head.Next == nil {
+		return true
+	}
+	// Find the end of the first half
+	slow, fast := head, head
+	for fast.Next != nil && fast.Next.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+	second := reverseNodes(slow.Next)
+	result := true
+	for p, q := head, second; q != nil; p, q = p.Next, q.Next {
+		if p.Data != q.Data {
+			result = false
+			break
+		}
+	}
+	slow.Next = reverseNodes(second)
+	return result
+}
+
+func reverseNodes[T any](head *Node[T]) *Node[T] {
+	var prev *Node[T]
+	current := head
+	for current != nil {
+		next := current.Next
+		current.Next = prev
+		prev, current = current, next
+	}
+	return prev
+}
@@ -0,0 +1,222 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+// chain links the values into nodes and returns the nodes in order
+func chain(values ...int) []*Node[int] {
+	nodes := make([]*Node[int], len(values))
+	for i := len(values) - 1; i >= 0; i-- {
+		nodes[i] = &Node[int]{Data: values[i]}
+		if i+1 < len(values) {
+			nodes[i].Next = nodes[i+1]
+		}
+	}
+	return nodes
+}
+
+func head(nodes []*Node[int]) *Node[int] {
+	if len(nodes) == 0 {
+		return nil
+	}
+	return nodes[0]
+}
+
+// valuesOf collects the values of an acyclic chain
+func valuesOf(h *Node[int]) []int {
+	var values []int
+	for ; h != nil; h = h.Next {
+		values = append(values, h.Data)
+	}
+	return values
+}
+
+func TestDetectCycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		size  int
+		entry int // index the last node links back to, or -1 for no cycle
+	}{
+		{"empty", 0, -1},
+		{"single node", 1, -1},
+		{"acyclic", 5, -1},
+		{"self loop", 1, 0},
+		{"cycle at the head", 5, 0},
+		{"cycle in the middle", 6, 2},
+		{"cycle at the tail", 5, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes := chain(make([]int, tt.size)...)
+			var want *Node[int]
+			if tt.entry >= 0 {
+				want = nodes[tt.entry]
+				nodes[len(nodes)-1].Next = want
+			}
+			if got := DetectCycle(head(nodes)); got != want {
+				t.Fatalf("DetectCycle returned the wrong node: got %p, want %p", got, want)
+			}
+		})
+	}
+}
+
+func TestMiddleNode(t *testing.T) {
+	tests := []struct {
+		values []int
+		want   int // index of the middle node, -1 for nil
+	}{
+		{nil, -1},
+		{[]int{1}, 0},
+		{[]int{1, 2}, 1},
+		{[]int{1, 2, 3}, 1},
+		{[]int{1, 2, 3, 4, 5}, 2},
+		{[]int{1, 2, 3, 4, 5, 6}, 3},
+	}
+	for _, tt := range tests {
+		nodes := chain(tt.values...)
+		got := MiddleNode(head(nodes))
+		if (tt.want < 0 && got != nil) || (tt.want >= 0 && got != nodes[tt.want]) {
+			t.Errorf("MiddleNode(%v) returned the wrong node", tt.values)
+		}
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		a, b, want []int
+	}{
+		{nil, nil, nil},
+		{[]int{1, 3}, nil, []int{1, 3}},
+		{nil, []int{2}, []int{2}},
+		{[]int{1, 4, 6}, []int{2, 3, 7, 8}, []int{1, 2, 3, 4, 6, 7, 8}},
+		{[]int{1, 1}, []int{1}, []int{1, 1, 1}},
+	}
+	for _, tt := range tests {
+		if got := valuesOf(MergeSorted(head(chain(tt.a...)), head(chain(tt.b...)))); !slices.Equal(got, tt.want) {
+			t.Errorf("MergeSorted(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+	// equal elements keep the first chain's nodes first
+	a, b := chain(1), chain(1)
+	if merged := MergeSorted(a[0], b[0]); merged != a[0] || merged.Next != b[0] {
+		t.Error("MergeSorted is not stable")
+	}
+}
+
+func TestMergeKSorted(t *testing.T) {
+	tests := []struct {
+		name  string
+		lists [][]int
+		want  []int
+	}{
+		{"nil slice", nil, nil},
+		{"no lists", [][]int{}, nil},
+		{"only empty lists", [][]int{nil, nil, nil}, nil},
+		{"one list", [][]int{{1, 2, 3}}, []int{1, 2, 3}},
+		{"empty lists mixed in", [][]int{nil, {2, 5}, nil, {1, 9}}, []int{1, 2, 5, 9}},
+		{"odd count", [][]int{{1, 4, 7}, {2, 5, 8}, {3, 6, 9}}, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+	}
+	for _, tt := range tests {
+		var heads []*Node[int]
+		if tt.lists != nil {
+			heads = []*Node[int]{}
+		}
+		for _, values := range tt.lists {
+			heads = append(heads, head(chain(values...)))
+		}
+		if got := valuesOf(MergeKSorted(heads)); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: MergeKSorted = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIntersectionNode(t *testing.T) {
+	shared := chain(8, 9)
+	a := chain(1, 2, 3)
+	a[2].Next = shared[0]
+	b := chain(4)
+	b[0].Next = shared[0]
+	separate := chain(1, 2, 3)
+
+	tests := []struct {
+		name string
+		a, b *Node[int]
+		want *Node[int]
+	}{
+		{"shared tail", a[0], b[0], shared[0]},
+		{"no intersection", a[0], separate[0], nil},
+		{"same values, different nodes", separate[0], chain(1, 2, 3)[0], nil},
+		{"one empty", a[0], nil, nil},
+		{"same chain", a[0], a[0], a[0]},
+		{"second starts inside the first", a[0], a[1], a[1]},
+	}
+	for _, tt := range tests {
+		if got := IntersectionNode(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: IntersectionNode returned the wrong node", tt.name)
+		}
+	}
+}
+
+func TestRemoveNthFromEnd(t *testing.T) {
+	tests := []struct {
+		values []int
+		n      int
+		want   []int
+	}{
+		{[]int{1, 2, 3, 4}, 1, []int{1, 2, 3}},
+		{[]int{1, 2, 3, 4}, 2, []int{1, 2, 4}},
+		{[]int{1, 2, 3, 4}, 4, []int{2, 3, 4}},
+		{[]int{1}, 1, nil},
+		// out of range leaves the chain unchanged
+		{[]int{1, 2, 3}, 0, []int{1, 2, 3}},
+		{[]int{1, 2, 3}, -1, []int{1, 2, 3}},
+		{[]int{1, 2, 3}, 4, []int{1, 2, 3}},
+		{nil, 1, nil},
+	}
+	for _, tt := range tests {
+		if got := valuesOf(RemoveNthFromEnd(head(chain(tt.values...)), tt.n)); !slices.Equal(got, tt.want) {
+			t.Errorf("RemoveNthFromEnd(%v, %d) = %v, want %v", tt.values, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestIsPalindrome(t *testing.T) {
+	tests := []struct {
+		values []int
+		want   bool
+	}{
+		{nil, true},
+		{[]int{7}, true},
+		{[]int{1, 1}, true},
+		{[]int{1, 2}, false},
+		{[]int{1, 2, 1}, true},
+		{[]int{1, 2, 2, 1}, true},
+		{[]int{1, 2, 3, 1}, false},
+		{[]int{1, 2, 3, 2, 2}, false},
+	}
+	for _, tt := range tests {
+		nodes := chain(tt.values...)
+		if got := IsPalindrome(head(nodes)); got != tt.want {
+			t.Errorf("IsPalindrome(%v) = %v, want %v", tt.values, got, tt.want)
+		}
+		// the second half is reversed during the check and must be restored
+		for i := range nodes {
+			var want *Node[int]
+			if i+1 < len(nodes) {
+				want = nodes[i+1]
+			}
+			if nodes[i].Next != want {
+				t.Fatalf("IsPalindrome(%v) did not restore the chain", tt.values)
+			}
+		}
+	}
+}
+
+func TestFromHead(t *testing.T) {
+	nodes := chain(3, 1, 2)
+	sll := FromHead(MergeSorted(nodes[0], nil))
+	checkSingly(t, sll, []int{3, 1, 2})
+	checkSingly(t, FromHead[int](nil), nil)
+}
@@ -1,34 +1,46 @@
-package main
+package list
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// ErrIndexOutOfRange is returned when a position is outside the list
+var ErrIndexOutOfRange = errors.New("list: index out of range")
 
 // Node struct for singly linked list
-type Node struct {
-	Data int
-	Next *Node
+type Node[T any] struct {
+	Data T
+	Next *Node[T]
 }
 
 // SinglyLinkedList struct
-type SinglyLinkedList struct {
-	Head *Node
+//
+// Tail and the element count are kept up to date by the methods below, so
+// appending and Len are O(1); code that relinks nodes by hand should go
+// through those methods instead of editing Head directly.
+type SinglyLinkedList[T any] struct {
+	Head *Node[T]
+	Tail *Node[T]
+	size int
 }
 
 // InsertAtEnd inserts a new node at the end of the singly linked list
-func (sll *SinglyLinkedList) InsertAtEnd(data int) {
-	newNode := &Node{Data: data}
+func (sll *SinglyLinkedList[T]) InsertAtEnd(data T) {
+	newNode := &Node[T]{Data: data}
+	sll.size++
 	if sll.Head == nil {
 		sll.Head = newNode
+		sll.Tail = newNode
 		return
 	}
-	current := sll.Head
-	for current.Next != nil {
-		current = current.Next
-	}
-	current.Next = newNode
+	sll.Tail.Next = newNode
+	sll.Tail = newNode
 }
 
 // Display prints all elements in the singly linked list
-func (sll *SinglyLinkedList) Display() {
+func (sll *SinglyLinkedList[T]) Display() {
 	current := sll.Head
 	for current != nil {
 		fmt.Print(current.Data, " -> ")
@@ -37,22 +49,154 @@ func (sll *SinglyLinkedList) Display() {
 	fmt.Println("nil")
 }
 
+// All returns an iterator over the elements from head to tail
+func (sll *SinglyLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := sll.Head; current != nil; current = current.Next {
+			if !yield(current.Data) {
+				return
+			}
+		}
+	}
+}
+
+// InsertAtHead inserts a new node at the beginning of the singly linked list
+func (sll *SinglyLinkedList[T]) InsertAtHead(data T) {
+	sll.Head = &Node[T]{Data: data, Next: sll.Head}
+	if sll.Tail == nil {
+		sll.Tail = sll.Head
+	}
+	sll.size++
+}
+
+// InsertAt inserts a new node so that it ends up at position index,
+// where 0 is the head and Len() is the end of the list
+func (sll *SinglyLinkedList[T]) InsertAt(index int, data T) error {
+	if index < 0 || index > sll.size {
+		return ErrIndexOutOfRange
+	}
+	switch index {
+	case 0:
+		sll.InsertAtHead(data)
+	case sll.size:
+		sll.InsertAtEnd(data)
+	default:
+		prev := sll.nodeAt(index - 1)
+		prev.Next = &Node[T]{Data: data, Next: prev.Next}
+		sll.size++
+	}
+	return nil
+}
+
+// DeleteAt removes the node at position index and returns its data
+func (sll *SinglyLinkedList[T]) DeleteAt(index int) (T, error) {
+	if index < 0 || index >= sll.size {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+	var prev *Node[T]
+	if index > 0 {
+		prev = sll.nodeAt(index - 1)
+	}
+	return sll.unlinkAfter(prev).Data, nil
+}
+
+// DeleteFunc removes the first node whose data satisfies match and reports whether one was found
+func (sll *SinglyLinkedList[T]) DeleteFunc(match func(T) bool) bool {
+	var prev *Node[T]
+	for current := sll.Head; current != nil; prev, current = current, current.Next {
+		if match(current.Data) {
+			sll.unlinkAfter(prev)
+			return true
+		}
+	}
+	return false
+}
+
+// Find returns the first node whose data satisfies match, or nil if there is none
+func (sll *SinglyLinkedList[T]) Find(match func(T) bool) *Node[T] {
+	for current := sll.Head; current != nil; current = current.Next {
+		if match(current.Data) {
+			return current
+		}
+	}
+	return nil
+}
+
+// Reverse reverses the singly linked list in place
+func (sll *SinglyLinkedList[T]) Reverse() {
+	var prev *Node[T]
+	current := sll.Head
+	sll.Tail = current
+	for current != nil {
+		next := current.Next
+		current.Next = prev
+		prev, current = current, next
+	}
+	sll.Head = prev
+}
+
+// Len returns the number of nodes in the singly linked list
+func (sll *SinglyLinkedList[T]) Len() int {
+	return sll.size
+}
+
+// ToSlice returns the elements of the singly linked list from head to tail
+func (sll *SinglyLinkedList[T]) ToSlice() []T {
+	values := []T{}
+	for data := range sll.All() {
+		values = append(values, data)
+	}
+	return values
+}
+
+func (sll *SinglyLinkedList[T]) nodeAt(index int) *Node[T] {
+	current := sll.Head
+	for i := 0; current != nil && i < index; i++ {
+		current = current.Next
+	}
+	return current
+}
+
+// unlinkAfter removes the node following prev, or the head when prev is nil
+func (sll *SinglyLinkedList[T]) unlinkAfter(prev *Node[T]) *Node[T] {
+	var removed *Node[T]
+	if prev == nil {
+		removed = sll.Head
+		sll.Head = removed.Next
+	} else {
+		removed = prev.Next
+		prev.Next = removed.Next
+	}
+	if removed == sll.Tail {
+		sll.Tail = prev
+	}
+	removed.Next = nil
+	sll.size--
+	return removed
+}
+
 // Node struct for doubly linked list
-type DNode struct {
-	Data int
-	Next *DNode
-	Prev *DNode
+type DNode[T any] struct {
+	Data T
+	Next *DNode[T]
+	Prev *DNode[T]
 }
 
 // DoublyLinkedList struct
-type DoublyLinkedList struct {
-	Head *DNode
-	Tail *DNode
+//
+// Like SinglyLinkedList it keeps its element count up to date in the methods
+// below, so Len is O(1).
+type DoublyLinkedList[T any] struct {
+	Head *DNode[T]
+	Tail *DNode[T]
+	size int
 }
 
 // InsertAtEnd inserts a new node at the end of the doubly linked list
-func (dll *DoublyLinkedList) InsertAtEnd(data int) {
-	newNode := &DNode{Data: data}
+func (dll *DoublyLinkedList[T]) InsertAtEnd(data T) {
+	newNode := &DNode[T]{Data: data}
+	dll.size++
 	if dll.Head == nil {
 		dll.Head = newNode
 		dll.Tail = newNode
@@ -64,7 +208,7 @@ func (dll *DoublyLinkedList) InsertAtEnd(data int) {
 }
 
 // Display prints all elements in the doubly linked list
-func (dll *DoublyLinkedList) Display() {
+func (dll *DoublyLinkedList[T]) Display() {
 	current := dll.Head
 	for current != nil {
 		fmt.Print(current.Data, " <-> ")
@@ -73,35 +217,172 @@ func (dll *DoublyLinkedList) Display() {
 	fmt.Println("nil")
 }
 
+// All returns an iterator over the elements from head to tail
+func (dll *DoublyLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := dll.Head; current != nil; current = current.Next {
+			if !yield(current.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements from tail to head
+func (dll *DoublyLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := dll.Tail; current != nil; current = current.Prev {
+			if !yield(current.Data) {
+				return
+			}
+		}
+	}
+}
+
+// InsertAtHead inserts a new node at the beginning of the doubly linked list
+func (dll *DoublyLinkedList[T]) InsertAtHead(data T) {
+	newNode := &DNode[T]{Data: data, Next: dll.Head}
+	if dll.Head == nil {
+		dll.Tail = newNode
+	} else {
+		dll.Head.Prev = newNode
+	}
+	dll.Head = newNode
+	dll.size++
+}
+
+// InsertAt inserts a new node so that it ends up at position index,
+// where 0 is the head and Len() is the end of the list
+func (dll *DoublyLinkedList[T]) InsertAt(index int, data T) error {
+	if index < 0 || index > dll.size {
+		return ErrIndexOutOfRange
+	}
+	switch index {
+	case 0:
+		dll.InsertAtHead(data)
+	case dll.size:
+		dll.InsertAtEnd(data)
+	default:
+		prev := dll.nodeAt(index - 1)
+		newNode := &DNode[T]{Data: data, Next: prev.Next, Prev: prev}
+		prev.Next.Prev = newNode
+		prev.Next = newNode
+		dll.size++
+	}
+	return nil
+}
+
+// DeleteAt removes the node at position index and returns its data
+func (dll *DoublyLinkedList[T]) DeleteAt(index int) (T, error) {
+	if index < 0 || index >= dll.size {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+	node := dll.nodeAt(index)
+	dll.unlink(node)
+	return node.Data, nil
+}
+
+// DeleteFunc removes the first node whose data satisfies match and reports whether one was found
+func (dll *DoublyLinkedList[T]) DeleteFunc(match func(T) bool) bool {
+	node := dll.Find(match)
+	if node == nil {
+		return false
+	}
+	dll.unlink(node)
+	return true
+}
+
+// Find returns the first node whose data satisfies match, or nil if there is none
+func (dll *DoublyLinkedList[T]) Find(match func(T) bool) *DNode[T] {
+	for current := dll.Head; current != nil; current = current.Next {
+		if match(current.Data) {
+			return current
+		}
+	}
+	return nil
+}
+
+// Reverse reverses the doubly linked list in place
+func (dll *DoublyLinkedList[T]) Reverse() {
+	current := dll.Head
+	for current != nil {
+		current.Next, current.Prev = current.Prev, current.Next
+		current = current.Prev
+	}
+	dll.Head, dll.Tail = dll.Tail, dll.Head
+}
+
+// Len returns the number of nodes in the doubly linked list
+func (dll *DoublyLinkedList[T]) Len() int {
+	return dll.size
+}
+
+// ToSlice returns the elements of the doubly linked list from head to tail
+func (dll *DoublyLinkedList[T]) ToSlice() []T {
+	values := []T{}
+	for data := range dll.All() {
+		values = append(values, data)
+	}
+	return values
+}
+
+func (dll *DoublyLinkedList[T]) nodeAt(index int) *DNode[T] {
+	current := dll.Head
+	for i := 0; current != nil && i < index; i++ {
+		current = current.Next
+	}
+	return current
+}
+
+func (dll *DoublyLinkedList[T]) unlink(node *DNode[T]) {
+	if node.Prev == nil {
+		dll.Head = node.Next
+	} else {
+		node.Prev.Next = node.Next
+	}
+	if node.Next == nil {
+		dll.Tail = node.Prev
+	} else {
+		node.Next.Prev = node.Prev
+	}
+	node.Next, node.Prev = nil, nil
+	dll.size--
+}
+
 // Node struct for circular linked list
-type CNode struct {
-	Data int
-	Next *CNode
+type CNode[T any] struct {
+	Data T
+	Next *CNode[T]
 }
 
 // CircularLinkedList struct
-type CircularLinkedList struct {
-	Head *CNode
+//
+// Tail is the node that links back to Head; like SinglyLinkedList it is
+// maintained together with the element count by the methods below.
+type CircularLinkedList[T any] struct {
+	Head *CNode[T]
+	Tail *CNode[T]
+	size int
 }
 
 // InsertAtEnd inserts a new node at the end of the circular linked list
-func (cll *CircularLinkedList) InsertAtEnd(data int) {
-	newNode := &CNode{Data: data}
+func (cll *CircularLinkedList[T]) InsertAtEnd(data T) {
+	newNode := &CNode[T]{Data: data}
+	cll.size++
 	if cll.Head == nil {
 		cll.Head = newNode
+		cll.Tail = newNode
 		newNode.Next = cll.Head
 		return
 	}
-	current := cll.Head
-	for current.Next != cll.Head {
-		current = current.Next
-	}
-	current.Next = newNode
+	cll.Tail.Next = newNode
 	newNode.Next = cll.Head
+	cll.Tail = newNode
 }
 
 // Display prints all elements in the circular linked list
-func (cll *CircularLinkedList) Display() {
+func (cll *CircularLinkedList[T]) Display() {
 	if cll.Head == nil {
 		fmt.Println("nil")
 		return
@@ -117,28 +398,202 @@ func (cll *CircularLinkedList) Display() {
 	fmt.Println("(head)")
 }
 
-func main() {
-	// Singly Linked List
-	sll := &SinglyLinkedList{}
-	sll.InsertAtEnd(1)
-	sll.InsertAtEnd(2)
-	sll.InsertAtEnd(3)
-	fmt.Print("Singly Linked List: ")
-	sll.Display()
-
-	// Doubly Linked List
-	dll := &DoublyLinkedList{}
-	dll.InsertAtEnd(1)
-	dll.InsertAtEnd(2)
-	dll.InsertAtEnd(3)
-	fmt.Print("Doubly Linked List: ")
-	dll.Display()
-
-	// Circular Linked List
-	cll := &CircularLinkedList{}
-	cll.InsertAtEnd(1)
-	cll.InsertAtEnd(2)
-	cll.InsertAtEnd(3)
-	fmt.Print("Circular Linked List: ")
-	cll.Display()
+// All returns an iterator that goes once around the ring starting at the head
+func (cll *CircularLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if cll.Head == nil {
+			return
+		}
+		current := cll.Head
+		for {
+			if !yield(current.Data) {
+				return
+			}
+			current = current.Next
+			if current == cll.Head {
+				return
+			}
+		}
+	}
+}
+
+// InsertAtHead inserts a new node at the beginning of the circular linked list
+func (cll *CircularLinkedList[T]) InsertAtHead(data T) {
+	if cll.Head == nil {
+		cll.InsertAtEnd(data)
+		return
+	}
+	cll.Head = &CNode[T]{Data: data, Next: cll.Head}
+	cll.Tail.Next = cll.Head
+	cll.size++
+}
+
+// InsertAt inserts a new node so that it ends up at position index,
+// where 0 is the head and Len() is the end of the list
+func (cll *CircularLinkedList[T]) InsertAt(index int, data T) error {
+	if index < 0 || index > cll.size {
+		return ErrIndexOutOfRange
+	}
+	switch index {
+	case 0:
+		cll.InsertAtHead(data)
+	case cll.size:
+		cll.InsertAtEnd(data)
+	default:
+		prev := cll.nodeAt(index - 1)
+		prev.Next = &CNode[T]{Data: data, Next: prev.Next}
+		cll.size++
+	}
+	return nil
+}
+
+// DeleteAt removes the node at position index and returns its data
+func (cll *CircularLinkedList[T]) DeleteAt(index int) (T, error) {
+	if index < 0 || index >= cll.size {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+	prev := cll.Tail
+	if index > 0 {
+		prev = cll.nodeAt(index - 1)
+	}
+	removed := prev.Next
+	cll.unlinkAfter(prev)
+	return removed.Data, nil
+}
+
+// DeleteFunc removes the first node whose data satisfies match and reports whether one was found
+func (cll *CircularLinkedList[T]) DeleteFunc(match func(T) bool) bool {
+	if cll.Head == nil {
+		return false
+	}
+	prev := cll.Tail
+	for {
+		if match(prev.Next.Data) {
+			cll.unlinkAfter(prev)
+			return true
+		}
+		prev = prev.Next
+		if prev == cll.Tail {
+			return false
+		}
+	}
+}
+
+// Find returns the first node whose data satisfies match, or nil if there is none
+func (cll *CircularLinkedList[T]) Find(match func(T) bool) *CNode[T] {
+	if cll.Head == nil {
+		return nil
+	}
+	current := cll.Head
+	for {
+		if match(current.Data) {
+			return current
+		}
+		current = current.Next
+		if current == cll.Head {
+			return nil
+		}
+	}
+}
+
+// Reverse reverses the circular linked list in place; the old last node becomes the head
+func (cll *CircularLinkedList[T]) Reverse() {
+	if cll.Head == nil {
+		return
+	}
+	prev := cll.Tail
+	current := cll.Head
+	for {
+		next := current.Next
+		current.Next = prev
+		prev, current = current, next
+		if current == cll.Head {
+			break
+		}
+	}
+	cll.Head, cll.Tail = cll.Tail, cll.Head
+}
+
+// Len returns the number of nodes in the circular linked list
+func (cll *CircularLinkedList[T]) Len() int {
+	return cll.size
+}
+
+// ToSlice returns the elements of the circular linked list starting at the head
+func (cll *CircularLinkedList[T]) ToSlice() []T {
+	values := []T{}
+	for data := range cll.All() {
+		values = append(values, data)
+	}
+	return values
+}
+
+// nodeAt returns the node at position index, or nil if the list is shorter
+func (cll *CircularLinkedList[T]) nodeAt(index int) *CNode[T] {
+	if cll.Head == nil {
+		return nil
+	}
+	current := cll.Head
+	for i := 0; i < index; i++ {
+		current = current.Next
+		if current == cll.Head {
+			return nil
+		}
+	}
+	return current
+}
+
+// unlinkAfter removes prev.Next while keeping the ring closed
+func (cll *CircularLinkedList[T]) unlinkAfter(prev *CNode[T]) {
+	removed := prev.Next
+	cll.size--
+	if removed == prev {
+		cll.Head = nil
+		cll.Tail = nil
+		return
+	}
+	prev.Next = removed.Next
+	if removed == cll.Head {
+		cll.Head = removed.Next
+	}
+	if removed == cll.Tail {
+		cll.Tail = prev
+	}
+}
+
+// iterable is implemented by every list type so the comparable helpers
+// below can visit elements from head to tail
+type iterable[T any] interface {
+	All() iter.Seq[T]
+}
+
+// Contains reports whether value is present in the list
+func Contains[T comparable](l iterable[T], value T) bool {
+	return IndexOf(l, value) != -1
+}
+
+// IndexOf returns the position of the first occurrence of value in the list, or -1 if it is not found
+func IndexOf[T comparable](l iterable[T], value T) int {
+	i := 0
+	for data := range l.All() {
+		if data == value {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// deleter is implemented by every list type so DeleteValue can remove by equality
+type deleter[T any] interface {
+	DeleteFunc(match func(T) bool) bool
+}
+
+// DeleteValue removes the first node holding value and reports whether one
+// was found. It is a function rather than a method because the list types
+// accept any element type and a method cannot require T to be comparable;
+// each list's DeleteFunc is the method form and works for any T.
+func DeleteValue[T comparable](l deleter[T], value T) bool {
+	return l.DeleteFunc(func(data T) bool { return data == value })
 }
@@ -0,0 +1,404 @@
+package list
+
+import (
+	"iter"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// checkSingly fails unless Tail, Len and the node chain agree with want
+func checkSingly(t *testing.T, sll *SinglyLinkedList[int], want []int) {
+	t.Helper()
+	if got := sll.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("singly list holds %v, want %v", got, want)
+	}
+	if sll.Len() != len(want) {
+		t.Fatalf("singly Len() = %d, want %d", sll.Len(), len(want))
+	}
+	var last *Node[int]
+	for current := sll.Head; current != nil; current = current.Next {
+		last = current
+	}
+	if sll.Tail != last {
+		t.Fatal("singly Tail is not the last node")
+	}
+}
+
+func checkDoubly(t *testing.T, dll *DoublyLinkedList[int], want []int) {
+	t.Helper()
+	if got := dll.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("doubly list holds %v, want %v", got, want)
+	}
+	if got := slices.Collect(dll.Backward()); !slices.Equal(got, reversed(want)) {
+		t.Fatalf("doubly list backwards holds %v, want %v", got, reversed(want))
+	}
+	if dll.Len() != len(want) {
+		t.Fatalf("doubly Len() = %d, want %d", dll.Len(), len(want))
+	}
+	if (dll.Head == nil) != (dll.Tail == nil) || (dll.Head != nil && (dll.Head.Prev != nil || dll.Tail.Next != nil)) {
+		t.Fatal("doubly Head and Tail are inconsistent")
+	}
+}
+
+func checkCircular(t *testing.T, cll *CircularLinkedList[int], want []int) {
+	t.Helper()
+	if got := cll.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("circular list holds %v, want %v", got, want)
+	}
+	if cll.Len() != len(want) {
+		t.Fatalf("circular Len() = %d, want %d", cll.Len(), len(want))
+	}
+	if len(want) == 0 {
+		if cll.Head != nil || cll.Tail != nil {
+			t.Fatal("empty circular list still has nodes")
+		}
+		return
+	}
+	if cll.Tail.Next != cll.Head {
+		t.Fatal("circular Tail does not link back to Head")
+	}
+}
+
+func reversed(values []int) []int {
+	out := slices.Clone(values)
+	slices.Reverse(out)
+	return out
+}
+
+// TestRandomOperations applies the same random insertions and deletions to
+// all three lists and a slice, checking Tail and Len after every step
+func TestRandomOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	sll := &SinglyLinkedList[int]{}
+	dll := &DoublyLinkedList[int]{}
+	cll := &CircularLinkedList[int]{}
+	var want []int
+	for step := range 3000 {
+		v := rng.Intn(20)
+		switch rng.Intn(6) {
+		case 0:
+			sll.InsertAtEnd(v)
+			dll.InsertAtEnd(v)
+			cll.InsertAtEnd(v)
+			want = append(want, v)
+		case 1:
+			sll.InsertAtHead(v)
+			dll.InsertAtHead(v)
+			cll.InsertAtHead(v)
+			want = slices.Insert(want, 0, v)
+		case 2:
+			i := rng.Intn(len(want) + 2)
+			errs := []error{sll.InsertAt(i, v), dll.InsertAt(i, v), cll.InsertAt(i, v)}
+			for _, err := range errs {
+				if (err != nil) != (i > len(want)) {
+					t.Fatalf("step %d: InsertAt(%d) on %d elements: err = %v", step, i, len(want), err)
+				}
+			}
+			if i <= len(want) {
+				want = slices.Insert(want, i, v)
+			}
+		case 3:
+			i := rng.Intn(len(want) + 1)
+			for _, deleteAt := range []func(int) (int, error){sll.DeleteAt, dll.DeleteAt, cll.DeleteAt} {
+				got, err := deleteAt(i)
+				if i >= len(want) {
+					if err == nil {
+						t.Fatalf("step %d: DeleteAt(%d) on %d elements succeeded", step, i, len(want))
+					}
+				} else if err != nil || got != want[i] {
+					t.Fatalf("step %d: DeleteAt(%d) = %d, %v; want %d", step, i, got, err, want[i])
+				}
+			}
+			if i < len(want) {
+				want = slices.Delete(want, i, i+1)
+			}
+		case 4:
+			i := slices.Index(want, v)
+			for _, l := range []deleter[int]{sll, dll, cll} {
+				if DeleteValue(l, v) != (i >= 0) {
+					t.Fatalf("step %d: DeleteValue(%d) disagrees with the slice", step, v)
+				}
+			}
+			if i >= 0 {
+				want = slices.Delete(want, i, i+1)
+			}
+		case 5:
+			sll.Reverse()
+			dll.Reverse()
+			cll.Reverse()
+			slices.Reverse(want)
+		}
+		checkSingly(t, sll, want)
+		checkDoubly(t, dll, want)
+		checkCircular(t, cll, want)
+	}
+}
+
+// walkingList is the list as it was before it tracked its tail and size:
+// appending and counting both walk from the head
+type walkingList struct {
+	head *Node[int]
+}
+
+func (w *walkingList) InsertAtEnd(data int) {
+	newNode := &Node[int]{Data: data}
+	if w.head == nil {
+		w.head = newNode
+		return
+	}
+	current := w.head
+	for current.Next != nil {
+		current = current.Next
+	}
+	current.Next = newNode
+}
+
+func (w *walkingList) Len() int {
+	n := 0
+	for current := w.head; current != nil; current = current.Next {
+		n++
+	}
+	return n
+}
+
+var benchmarkSizes = []struct {
+	name string
+	n    int
+}{
+	{"1k", 1000},
+	{"10k", 10000},
+}
+
+// BenchmarkInsertAtEnd builds a list of n elements by appending; the
+// tracked tail keeps the cost per element flat while walking grows with n
+func BenchmarkInsertAtEnd(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run("tail/"+size.name, func(b *testing.B) {
+			for range b.N {
+				var sll SinglyLinkedList[int]
+				for i := range size.n {
+					sll.InsertAtEnd(i)
+				}
+			}
+		})
+		b.Run("circular/"+size.name, func(b *testing.B) {
+			for range b.N {
+				var cll CircularLinkedList[int]
+				for i := range size.n {
+					cll.InsertAtEnd(i)
+				}
+			}
+		})
+		b.Run("walk/"+size.name, func(b *testing.B) {
+			for range b.N {
+				var w walkingList
+				for i := range size.n {
+					w.InsertAtEnd(i)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkLen compares reading the tracked size against counting nodes
+func BenchmarkLen(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		var sll SinglyLinkedList[int]
+		var dll DoublyLinkedList[int]
+		var w walkingList
+		for i := range size.n {
+			sll.InsertAtEnd(i)
+			dll.InsertAtEnd(i)
+			w.InsertAtEnd(i)
+		}
+		b.Run("singly/"+size.name, func(b *testing.B) {
+			for range b.N {
+				sll.Len()
+			}
+		})
+		b.Run("doubly/"+size.name, func(b *testing.B) {
+			for range b.N {
+				dll.Len()
+			}
+		})
+		b.Run("walk/"+size.name, func(b *testing.B) {
+			for range b.N {
+				w.Len()
+			}
+		})
+	}
+}
+
+func TestDeleteValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []int
+		value   int
+		found   bool
+		want    []int
+	}{
+		{"head", []int{1, 2, 3, 2}, 1, true, []int{2, 3, 2}},
+		{"middle", []int{1, 2, 3, 2}, 3, true, []int{1, 2, 2}},
+		{"tail", []int{1, 2, 3, 2, 4}, 4, true, []int{1, 2, 3, 2}},
+		{"first of duplicates", []int{1, 2, 3, 2}, 2, true, []int{1, 3, 2}},
+		{"missing", []int{1, 2, 3, 2}, 9, false, []int{1, 2, 3, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sll := &SinglyLinkedList[int]{}
+			dll := &DoublyLinkedList[int]{}
+			cll := &CircularLinkedList[int]{}
+			for _, v := range tt.initial {
+				sll.InsertAtEnd(v)
+				dll.InsertAtEnd(v)
+				cll.InsertAtEnd(v)
+			}
+			for _, l := range []deleter[int]{sll, dll, cll} {
+				if got := DeleteValue(l, tt.value); got != tt.found {
+					t.Fatalf("%T: DeleteValue(%d) = %v, want %v", l, tt.value, got, tt.found)
+				}
+			}
+			checkSingly(t, sll, tt.want)
+			checkDoubly(t, dll, tt.want)
+			checkCircular(t, cll, tt.want)
+
+			// the list keeps working at both ends after the delete
+			sll.InsertAtEnd(7)
+			dll.InsertAtEnd(7)
+			cll.InsertAtEnd(7)
+			want := append(slices.Clone(tt.want), 7)
+			checkSingly(t, sll, want)
+			checkDoubly(t, dll, want)
+			checkCircular(t, cll, want)
+		})
+	}
+}
+
+func TestDeleteValueDownToEmpty(t *testing.T) {
+	sll := &SinglyLinkedList[int]{}
+	dll := &DoublyLinkedList[int]{}
+	cll := &CircularLinkedList[int]{}
+	for _, l := range []interface {
+		deleter[int]
+		InsertAtEnd(int)
+	}{sll, dll, cll} {
+		if DeleteValue(l, 1) {
+			t.Fatalf("%T: DeleteValue on an empty list found a value", l)
+		}
+		l.InsertAtEnd(1)
+		if !DeleteValue(l, 1) {
+			t.Fatalf("%T: DeleteValue did not find the only value", l)
+		}
+	}
+	checkSingly(t, sll, nil)
+	checkDoubly(t, dll, nil)
+	checkCircular(t, cll, nil)
+	if sll.Head != nil || sll.Tail != nil || dll.Head != nil || dll.Tail != nil {
+		t.Fatal("emptied list still points at a node")
+	}
+}
+
+func TestIterators(t *testing.T) {
+	for _, values := range [][]int{nil, {1}, {1, 2, 3}} {
+		sll := &SinglyLinkedList[int]{}
+		dll := &DoublyLinkedList[int]{}
+		cll := &CircularLinkedList[int]{}
+		for _, v := range values {
+			sll.InsertAtEnd(v)
+			dll.InsertAtEnd(v)
+			cll.InsertAtEnd(v)
+		}
+		tests := []struct {
+			name string
+			seq  iter.Seq[int]
+			want []int
+		}{
+			{"singly All", sll.All(), values},
+			{"doubly All", dll.All(), values},
+			{"doubly Backward", dll.Backward(), reversed(values)},
+			{"circular All", cll.All(), values},
+		}
+		for _, tt := range tests {
+			if got := slices.Collect(tt.seq); !slices.Equal(got, tt.want) {
+				t.Errorf("%s of %v = %v, want %v", tt.name, values, got, tt.want)
+			}
+		}
+	}
+}
+
+// TestIteratorsStopEarly breaks out of each iterator; the range loop panics
+// if an iterator keeps calling yield after the body has stopped it
+func TestIteratorsStopEarly(t *testing.T) {
+	sll := &SinglyLinkedList[int]{}
+	dll := &DoublyLinkedList[int]{}
+	cll := &CircularLinkedList[int]{}
+	for v := range 5 {
+		sll.InsertAtEnd(v)
+		dll.InsertAtEnd(v)
+		cll.InsertAtEnd(v)
+	}
+	tests := []struct {
+		name string
+		seq  iter.Seq[int]
+		want []int
+	}{
+		{"singly All", sll.All(), []int{0, 1}},
+		{"doubly All", dll.All(), []int{0, 1}},
+		{"doubly Backward", dll.Backward(), []int{4, 3}},
+		{"circular All", cll.All(), []int{0, 1}},
+	}
+	for _, tt := range tests {
+		var got []int
+		for v := range tt.seq {
+			got = append(got, v)
+			if len(got) == 2 {
+				break
+			}
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s stopped after 2 values yielded %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestContainsAndIndexOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		value  int
+		want   int
+	}{
+		{"empty list", nil, 1, -1},
+		{"missing", []int{1, 2, 3}, 9, -1},
+		{"first position", []int{1, 2, 3}, 1, 0},
+		{"last position", []int{1, 2, 3}, 3, 2},
+		{"duplicates", []int{5, 7, 5, 7}, 7, 1},
+		{"single element", []int{4}, 4, 0},
+	}
+	for _, tt := range tests {
+		sll := &SinglyLinkedList[int]{}
+		dll := &DoublyLinkedList[int]{}
+		cll := &CircularLinkedList[int]{}
+		for _, v := range tt.values {
+			sll.InsertAtEnd(v)
+			dll.InsertAtEnd(v)
+			cll.InsertAtEnd(v)
+		}
+		for _, l := range []iterable[int]{sll, dll, cll} {
+			if got := IndexOf(l, tt.value); got != tt.want {
+				t.Errorf("%s: %T: IndexOf(%v, %d) = %d, want %d", tt.name, l, tt.values, tt.value, got, tt.want)
+			}
+			if got := Contains(l, tt.value); got != (tt.want >= 0) {
+				t.Errorf("%s: %T: Contains(%v, %d) = %v, want %v", tt.name, l, tt.values, tt.value, got, tt.want >= 0)
+			}
+		}
+	}
+	// the helpers work for any comparable element type
+	names := &DoublyLinkedList[string]{}
+	names.InsertAtEnd("ada")
+	names.InsertAtEnd("grace")
+	if IndexOf(names, "grace") != 1 || Contains(names, "linus") {
+		t.Error("IndexOf and Contains disagree on a list of strings")
+	}
+}
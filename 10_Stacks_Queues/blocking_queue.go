@@ -0,0 +1,135 @@
+package stackqueue
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrClosed is returned by a BlockingQueue once it has been closed and, for
+// takers, drained
+var ErrClosed = errors.New("stackqueue: queue closed")
+
+// BlockingQueue is a bounded FIFO queue that is safe for concurrent use.
+// Producers block while it is full and consumers block while it is empty.
+//
+// Waiters are woken through channels that are closed and replaced on every
+// state change, which lets Offer and Poll give up when their context ends.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	items    Deque[T]
+	capacity int
+	closed   bool
+	notEmpty chan struct{}
+	notFull  chan struct{}
+}
+
+// NewBlockingQueue creates a blocking queue that holds at most capacity items
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	if capacity < 1 {
+		panic("stackqueue: BlockingQueue capacity must be positive")
+	}
+	return &BlockingQueue[T]{
+		capacity: capacity,
+		notEmpty: make(chan struct{}),
+		notFull:  make(chan struct{}),
+	}
+}
+
+// Put adds an item, waiting for space if the queue is full.
+// It returns ErrClosed if the queue is closed.
+func (q *BlockingQueue[T]) Put(item T) error {
+	return q.Offer(context.Background(), item)
+}
+
+// Take removes and returns the front item, waiting for one if the queue is empty.
+// After Close it keeps returning the remaining items and then ErrClosed.
+func (q *BlockingQueue[T]) Take() (T, error) {
+	return q.Poll(context.Background())
+}
+
+// Offer adds an item, waiting for space until ctx is done.
+// It returns ErrClosed if the queue is closed, or ctx.Err() on timeout.
+func (q *BlockingQueue[T]) Offer(ctx context.Context, item T) error {
+	for {
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return ErrClosed
+		}
+		if q.items.Len() < q.capacity {
+			q.items.PushBack(item)
+			broadcast(&q.notEmpty)
+			q.mu.Unlock()
+			return nil
+		}
+		wait := q.notFull
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Poll removes and returns the front item, waiting for one until ctx is done.
+// It returns ErrClosed once the queue is closed and drained, or ctx.Err() on timeout.
+func (q *BlockingQueue[T]) Poll(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		if !q.items.IsEmpty() {
+			item, _ := q.items.PopFront()
+			broadcast(&q.notFull)
+			q.mu.Unlock()
+			return item, nil
+		}
+		if q.closed {
+			q.mu.Unlock()
+			var zero T
+			return zero, ErrClosed
+		}
+		wait := q.notEmpty
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// Close stops the queue from accepting new items and wakes every waiter.
+// Items already queued can still be taken. Closing twice is a no-op.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	q.closed = true
+	broadcast(&q.notEmpty)
+	broadcast(&q.notFull)
+}
+
+// Len returns the number of items currently in the queue
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Len()
+}
+
+// Cap returns the maximum number of items the queue holds
+func (q *BlockingQueue[T]) Cap() int {
+	return q.capacity
+}
+
+// broadcast wakes everyone waiting on *ch and installs a fresh channel for
+// the next round of waiters; the caller must hold the queue's mutex
+func broadcast(ch *chan struct{}) {
+	close(*ch)
+	*ch = make(chan struct{})
+}
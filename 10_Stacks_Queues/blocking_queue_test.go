@@ -0,0 +1,254 @@
+package stackqueue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockedFor is how long a call has to stay blocked to count as waiting
+const blockedFor = 20 * time.Millisecond
+
+// returns runs fn in a goroutine and returns a channel that receives its error
+func returns(fn func() error) <-chan error {
+	done := make(chan error, 1)
+	go func() { done <- fn() }()
+	return done
+}
+
+func expectBlocked(t *testing.T, done <-chan error, what string) {
+	t.Helper()
+	select {
+	case err := <-done:
+		t.Fatalf("%s returned %v instead of blocking", what, err)
+	case <-time.After(blockedFor):
+	}
+}
+
+func expectReturn(t *testing.T, done <-chan error, want error, what string) {
+	t.Helper()
+	select {
+	case err := <-done:
+		if !errors.Is(err, want) {
+			t.Fatalf("%s: err = %v, want %v", what, err, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("%s is still blocked", what)
+	}
+}
+
+func TestBlockingQueueFIFO(t *testing.T) {
+	q := NewBlockingQueue[int](3)
+	for i := range 3 {
+		if err := q.Put(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if q.Len() != 3 || q.Cap() != 3 {
+		t.Fatalf("Len(), Cap() = %d, %d; want 3, 3", q.Len(), q.Cap())
+	}
+	for want := range 3 {
+		if got, err := q.Take(); err != nil || got != want {
+			t.Fatalf("Take() = %d, %v; want %d", got, err, want)
+		}
+	}
+}
+
+func TestNewBlockingQueuePanicsOnBadCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("NewBlockingQueue(0) did not panic")
+		}
+	}()
+	NewBlockingQueue[int](0)
+}
+
+func TestPutBlocksWhileFull(t *testing.T) {
+	q := NewBlockingQueue[int](1)
+	q.Put(1)
+	done := returns(func() error { return q.Put(2) })
+	expectBlocked(t, done, "Put on a full queue")
+	if got, _ := q.Take(); got != 1 {
+		t.Fatalf("Take() = %d, want 1", got)
+	}
+	expectReturn(t, done, nil, "Put after space was freed")
+	if got, _ := q.Take(); got != 2 {
+		t.Fatalf("Take() = %d, want 2", got)
+	}
+}
+
+func TestTakeBlocksWhileEmpty(t *testing.T) {
+	q := NewBlockingQueue[int](1)
+	var got int
+	done := returns(func() error {
+		var err error
+		got, err = q.Take()
+		return err
+	})
+	expectBlocked(t, done, "Take on an empty queue")
+	q.Put(7)
+	expectReturn(t, done, nil, "Take after an item arrived")
+	if got != 7 {
+		t.Fatalf("Take() = %d, want 7", got)
+	}
+}
+
+func TestOfferAndPollTimeouts(t *testing.T) {
+	q := NewBlockingQueue[int](1)
+	ctx, cancel := context.WithTimeout(context.Background(), blockedFor)
+	defer cancel()
+	if _, err := q.Poll(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Poll on an empty queue: err = %v, want DeadlineExceeded", err)
+	}
+
+	q.Put(1)
+	ctx, cancel = context.WithTimeout(context.Background(), blockedFor)
+	defer cancel()
+	if err := q.Offer(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Offer on a full queue: err = %v, want DeadlineExceeded", err)
+	}
+	if q.Len() != 1 {
+		t.Fatalf("a timed-out Offer left %d items, want 1", q.Len())
+	}
+
+	// with room available a done context does not get in the way
+	if got, err := q.Poll(ctx); err != nil || got != 1 {
+		t.Fatalf("Poll with an item present = %d, %v; want 1", got, err)
+	}
+}
+
+func TestOfferAndPollCancellation(t *testing.T) {
+	q := NewBlockingQueue[int](1)
+	ctx, cancel := context.WithCancel(context.Background())
+	poll := returns(func() error {
+		_, err := q.Poll(ctx)
+		return err
+	})
+	expectBlocked(t, poll, "Poll on an empty queue")
+	cancel()
+	expectReturn(t, poll, context.Canceled, "Poll after cancel")
+
+	q.Put(1)
+	ctx, cancel = context.WithCancel(context.Background())
+	offer := returns(func() error { return q.Offer(ctx, 2) })
+	expectBlocked(t, offer, "Offer on a full queue")
+	cancel()
+	expectReturn(t, offer, context.Canceled, "Offer after cancel")
+	if q.Len() != 1 {
+		t.Fatalf("a cancelled Offer left %d items, want 1", q.Len())
+	}
+}
+
+func TestCloseDrainsThenFails(t *testing.T) {
+	q := NewBlockingQueue[int](4)
+	for i := range 3 {
+		q.Put(i)
+	}
+	q.Close()
+	q.Close()
+	if err := q.Put(9); !errors.Is(err, ErrClosed) {
+		t.Fatalf("Put after Close: err = %v, want ErrClosed", err)
+	}
+	for want := range 3 {
+		if got, err := q.Take(); err != nil || got != want {
+			t.Fatalf("Take() while draining = %d, %v; want %d", got, err, want)
+		}
+	}
+	for range 2 {
+		if _, err := q.Take(); !errors.Is(err, ErrClosed) {
+			t.Fatalf("Take after draining: err = %v, want ErrClosed", err)
+		}
+	}
+}
+
+func TestCloseWakesWaiters(t *testing.T) {
+	empty := NewBlockingQueue[int](1)
+	take := returns(func() error {
+		_, err := empty.Take()
+		return err
+	})
+	full := NewBlockingQueue[int](1)
+	full.Put(1)
+	put := returns(func() error { return full.Put(2) })
+	expectBlocked(t, take, "Take on an empty queue")
+	expectBlocked(t, put, "Put on a full queue")
+
+	empty.Close()
+	full.Close()
+	expectReturn(t, take, ErrClosed, "Take woken by Close")
+	expectReturn(t, put, ErrClosed, "Put woken by Close")
+	if got, err := full.Take(); err != nil || got != 1 {
+		t.Fatalf("Take of the queued item after Close = %d, %v; want 1", got, err)
+	}
+}
+
+// TestBlockingQueueProducersConsumers pushes items through a small queue
+// from several producers to several consumers. Every item must arrive
+// exactly once, in order per producer, and the queue must never overfill.
+func TestBlockingQueueProducersConsumers(t *testing.T) {
+	const producers, consumers, items = 6, 6, 3000
+	q := NewBlockingQueue[int](8)
+
+	var producing sync.WaitGroup
+	for p := range producers {
+		producing.Add(1)
+		go func() {
+			defer producing.Done()
+			for i := range items {
+				if err := q.Put(p*items + i); err != nil {
+					t.Errorf("Put: %v", err)
+					return
+				}
+			}
+		}()
+	}
+
+	var consuming sync.WaitGroup
+	taken := make([][]int, consumers)
+	for c := range consumers {
+		consuming.Add(1)
+		go func() {
+			defer consuming.Done()
+			for {
+				v, err := q.Take()
+				if errors.Is(err, ErrClosed) {
+					return
+				}
+				if n := q.Len(); n > q.Cap() {
+					t.Errorf("queue holds %d items, capacity %d", n, q.Cap())
+				}
+				taken[c] = append(taken[c], v)
+			}
+		}()
+	}
+
+	producing.Wait()
+	q.Close()
+	consuming.Wait()
+
+	seen := make([]bool, producers*items)
+	for c, values := range taken {
+		last := make([]int, producers)
+		for p := range last {
+			last[p] = -1
+		}
+		for _, v := range values {
+			if seen[v] {
+				t.Fatalf("item %d taken twice", v)
+			}
+			seen[v] = true
+			p := v / items
+			if v <= last[p] {
+				t.Fatalf("consumer %d took %d after %d from the same producer", c, v, last[p])
+			}
+			last[p] = v
+		}
+	}
+	for v, ok := range seen {
+		if !ok {
+			t.Fatalf("item %d was lost", v)
+		}
+	}
+}
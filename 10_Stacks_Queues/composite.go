@@ -0,0 +1,86 @@
+package stackqueue
+
+// QueueFromStacks is a FIFO queue built from two stacks. Items are pushed
+// onto an inbox stack; when the outbox runs dry the whole inbox is poured
+// into it, which reverses the order so the oldest item ends up on top. Each
+// item is moved at most once, so every operation is amortized O(1).
+type QueueFromStacks[T any] struct {
+	inbox  Stack[T]
+	outbox Stack[T]
+}
+
+// Enqueue adds an item to the queue
+func (q *QueueFromStacks[T]) Enqueue(item T) {
+	q.inbox.Push(item)
+}
+
+// Dequeue removes and returns the front item from the queue
+func (q *QueueFromStacks[T]) Dequeue() (T, error) {
+	q.refill()
+	return q.outbox.Pop()
+}
+
+// Front returns the front item from the queue without removing it
+func (q *QueueFromStacks[T]) Front() (T, error) {
+	q.refill()
+	return q.outbox.Peek()
+}
+
+// Len returns the number of items in the queue
+func (q *QueueFromStacks[T]) Len() int {
+	return q.inbox.Len() + q.outbox.Len()
+}
+
+// IsEmpty reports whether the queue has no items
+func (q *QueueFromStacks[T]) IsEmpty() bool {
+	return q.Len() == 0
+}
+
+func (q *QueueFromStacks[T]) refill() {
+	if !q.outbox.IsEmpty() {
+		return
+	}
+	for !q.inbox.IsEmpty() {
+		item, _ := q.inbox.Pop()
+		q.outbox.Push(item)
+	}
+}
+
+// StackFromQueues is a LIFO stack built from two queues. Push enqueues the
+// new item on the empty queue and then moves every older item behind it, so
+// the front of the main queue is always the top of the stack: Push is O(n),
+// Pop and Peek are O(1).
+type StackFromQueues[T any] struct {
+	main  Queue[T]
+	spare Queue[T]
+}
+
+// Push adds an item to the stack
+func (s *StackFromQueues[T]) Push(item T) {
+	s.spare.Enqueue(item)
+	for !s.main.IsEmpty() {
+		older, _ := s.main.Dequeue()
+		s.spare.Enqueue(older)
+	}
+	s.main, s.spare = s.spare, s.main
+}
+
+// Pop removes and returns the top item from the stack
+func (s *StackFromQueues[T]) Pop() (T, error) {
+	return s.main.Dequeue()
+}
+
+// Peek returns the top item from the stack without removing it
+func (s *StackFromQueues[T]) Peek() (T, error) {
+	return s.main.Front()
+}
+
+// Len returns the number of items in the stack
+func (s *StackFromQueues[T]) Len() int {
+	return s.main.Len()
+}
+
+// IsEmpty reports whether the stack has no items
+func (s *StackFromQueues[T]) IsEmpty() bool {
+	return s.main.IsEmpty()
+}
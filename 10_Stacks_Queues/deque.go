@@ -0,0 +1,174 @@
+package stackqueue
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrOutOfRange is returned by Deque.At for an index outside [0, Len())
+var ErrOutOfRange = errors.New("stackqueue: index out of range")
+
+// minCapacity is the smallest ring a Deque allocates; capacities are always
+// powers of two so positions can be wrapped with a mask instead of modulo
+const minCapacity = 8
+
+// Deque is a double-ended queue backed by a growable ring buffer.
+// The buffer doubles when full and halves when occupancy drops to a quarter,
+// so memory follows the number of live items rather than the peak.
+type Deque[T any] struct {
+	buf   []T
+	head  int
+	count int
+}
+
+// PushBack adds an item to the back of the deque
+func (d *Deque[T]) PushBack(item T) {
+	d.growIfFull()
+	d.buf[d.index(d.count)] = item
+	d.count++
+}
+
+// PushFront adds an item to the front of the deque
+func (d *Deque[T]) PushFront(item T) {
+	d.growIfFull()
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = item
+	d.count++
+}
+
+// PopFront removes and returns the front item of the deque
+func (d *Deque[T]) PopFront() (T, error) {
+	var zero T
+	if d.count == 0 {
+		return zero, ErrEmpty
+	}
+	item := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.count--
+	d.shrinkIfSparse()
+	return item, nil
+}
+
+// PopBack removes and returns the back item of the deque
+func (d *Deque[T]) PopBack() (T, error) {
+	var zero T
+	if d.count == 0 {
+		return zero, ErrEmpty
+	}
+	i := d.index(d.count - 1)
+	item := d.buf[i]
+	d.buf[i] = zero
+	d.count--
+	d.shrinkIfSparse()
+	return item, nil
+}
+
+// Front returns the front item of the deque without removing it
+func (d *Deque[T]) Front() (T, error) {
+	if d.count == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return d.buf[d.head], nil
+}
+
+// Back returns the back item of the deque without removing it
+func (d *Deque[T]) Back() (T, error) {
+	if d.count == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return d.buf[d.index(d.count-1)], nil
+}
+
+// At returns the item at position i, where 0 is the front
+func (d *Deque[T]) At(i int) (T, error) {
+	if i < 0 || i >= d.count {
+		var zero T
+		return zero, ErrOutOfRange
+	}
+	return d.buf[d.index(i)], nil
+}
+
+// Len returns the number of items in the deque
+func (d *Deque[T]) Len() int {
+	return d.count
+}
+
+// IsEmpty reports whether the deque has no items
+func (d *Deque[T]) IsEmpty() bool {
+	return d.count == 0
+}
+
+// Clear removes all items from the deque and releases its buffer
+func (d *Deque[T]) Clear() {
+	d.buf = nil
+	d.head = 0
+	d.count = 0
+}
+
+// Values returns the items from the front of the deque to the back
+func (d *Deque[T]) Values() []T {
+	values := make([]T, 0, d.count)
+	for item := range d.All() {
+		values = append(values, item)
+	}
+	return values
+}
+
+// All returns an iterator over the items from the front of the deque to the back
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.count; i++ {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the items from the back of the deque to the front
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.count - 1; i >= 0; i-- {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// index maps a position relative to the front onto the ring
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) & (len(d.buf) - 1)
+}
+
+func (d *Deque[T]) growIfFull() {
+	if d.buf == nil {
+		d.buf = make([]T, minCapacity)
+		return
+	}
+	if d.count == len(d.buf) {
+		d.resize(len(d.buf) * 2)
+	}
+}
+
+func (d *Deque[T]) shrinkIfSparse() {
+	if len(d.buf) > minCapacity && d.count <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+// resize copies the items into a new ring of the given capacity, front first
+func (d *Deque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	if d.head+d.count <= len(d.buf) {
+		copy(buf, d.buf[d.head:d.head+d.count])
+	} else {
+		n := copy(buf, d.buf[d.head:])
+		copy(buf[n:], d.buf[:d.count-n])
+	}
+	d.buf = buf
+	d.head = 0
+}
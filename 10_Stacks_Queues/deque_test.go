@@ -0,0 +1,274 @@
+package stackqueue
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestDequeEmpty(t *testing.T) {
+	var d Deque[int]
+	if _, err := d.PopFront(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("PopFront: err = %v, want ErrEmpty", err)
+	}
+	if _, err := d.PopBack(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("PopBack: err = %v, want ErrEmpty", err)
+	}
+	if _, err := d.Front(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Front: err = %v, want ErrEmpty", err)
+	}
+	if _, err := d.Back(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Back: err = %v, want ErrEmpty", err)
+	}
+	if _, err := d.At(0); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("At(0): err = %v, want ErrOutOfRange", err)
+	}
+	// popping the last item leaves a deque that is empty again
+	d.PushBack(1)
+	d.PopFront()
+	if !d.IsEmpty() || d.Len() != 0 {
+		t.Errorf("deque holds %d items after popping the only one", d.Len())
+	}
+	if _, err := d.PopBack(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("PopBack after emptying: err = %v, want ErrEmpty", err)
+	}
+}
+
+func TestDequeWraparound(t *testing.T) {
+	var d Deque[int]
+	for i := range 6 {
+		d.PushBack(i)
+	}
+	for range 5 {
+		d.PopFront()
+	}
+	// the ring holds 5 at index 5; these wrap around the end of the buffer
+	for i := 6; i < 12; i++ {
+		d.PushBack(i)
+	}
+	if len(d.buf) != minCapacity {
+		t.Fatalf("buffer grew to %d while only %d items were live", len(d.buf), d.Len())
+	}
+	if d.head+d.count <= len(d.buf) {
+		t.Fatal("items did not wrap around the end of the buffer")
+	}
+	want := []int{5, 6, 7, 8, 9, 10, 11}
+	if got := d.Values(); !slices.Equal(got, want) {
+		t.Fatalf("Values() = %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got, err := d.At(i); err != nil || got != v {
+			t.Fatalf("At(%d) = %d, %v; want %d", i, got, err, v)
+		}
+	}
+	if got := slices.Collect(d.Backward()); !slices.Equal(got, []int{11, 10, 9, 8, 7, 6, 5}) {
+		t.Fatalf("Backward() = %v", got)
+	}
+
+	// PushFront wraps the other way, below index 0
+	var front Deque[int]
+	front.PushFront(1)
+	front.PushFront(0)
+	front.PushBack(2)
+	if front.head != len(front.buf)-2 {
+		t.Fatalf("head = %d after two PushFronts, want %d", front.head, len(front.buf)-2)
+	}
+	if got := front.Values(); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("Values() = %v, want [0 1 2]", got)
+	}
+}
+
+func TestDequeGrowth(t *testing.T) {
+	var d Deque[int]
+	// start from a wrapped ring so growing has to unwrap it
+	for i := range 4 {
+		d.PushBack(i)
+	}
+	for i := 1; i <= 4; i++ {
+		d.PushFront(-i)
+	}
+	for i := 4; i < 100; i++ {
+		d.PushBack(i)
+		if n := len(d.buf); n&(n-1) != 0 || n < d.count {
+			t.Fatalf("capacity %d for %d items is not a power of two that fits", n, d.count)
+		}
+	}
+	want := []int{-4, -3, -2, -1}
+	for i := range 100 {
+		want = append(want, i)
+	}
+	if got := d.Values(); !slices.Equal(got, want) {
+		t.Fatalf("Values() after growing = %v, want %v", got, want)
+	}
+	if len(d.buf) != 128 {
+		t.Fatalf("capacity = %d for 104 items, want 128", len(d.buf))
+	}
+}
+
+func TestDequeShrink(t *testing.T) {
+	var d Deque[*int]
+	for i := range 1000 {
+		d.PushBack(&i)
+	}
+	peak := len(d.buf)
+	for d.Len() > 1 {
+		if d.Len()%2 == 0 {
+			d.PopFront()
+		} else {
+			d.PopBack()
+		}
+		if len(d.buf) > minCapacity && d.count <= len(d.buf)/4 {
+			t.Fatalf("capacity %d kept for %d items", len(d.buf), d.count)
+		}
+	}
+	if len(d.buf) != minCapacity || peak != 1024 {
+		t.Fatalf("capacity went from %d to %d, want 1024 to %d", peak, len(d.buf), minCapacity)
+	}
+	// popped slots are cleared so they do not keep items alive
+	live := 0
+	for _, p := range d.buf {
+		if p != nil {
+			live++
+		}
+	}
+	if live != 1 {
+		t.Fatalf("%d slots hold pointers with 1 item in the deque", live)
+	}
+	d.Clear()
+	if d.buf != nil || d.Len() != 0 {
+		t.Fatal("Clear did not release the buffer")
+	}
+}
+
+func TestDequeAgainstSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	var d Deque[int]
+	var want []int
+	for step := range 20000 {
+		// drift between growing and shrinking phases
+		grow := (step/2000)%2 == 0
+		switch op := rng.Intn(4); {
+		case op == 0 || (grow && op == 2):
+			d.PushBack(step)
+			want = append(want, step)
+		case op == 1 || (grow && op == 3):
+			d.PushFront(step)
+			want = slices.Insert(want, 0, step)
+		case op == 2:
+			got, err := d.PopFront()
+			if len(want) == 0 {
+				if !errors.Is(err, ErrEmpty) {
+					t.Fatalf("step %d: PopFront on empty: err = %v", step, err)
+				}
+				continue
+			}
+			if err != nil || got != want[0] {
+				t.Fatalf("step %d: PopFront() = %d, %v; want %d", step, got, err, want[0])
+			}
+			want = want[1:]
+		default:
+			got, err := d.PopBack()
+			if len(want) == 0 {
+				if !errors.Is(err, ErrEmpty) {
+					t.Fatalf("step %d: PopBack on empty: err = %v", step, err)
+				}
+				continue
+			}
+			if err != nil || got != want[len(want)-1] {
+				t.Fatalf("step %d: PopBack() = %d, %v; want %d", step, got, err, want[len(want)-1])
+			}
+			want = want[:len(want)-1]
+		}
+		if d.Len() != len(want) {
+			t.Fatalf("step %d: Len() = %d, want %d", step, d.Len(), len(want))
+		}
+		if len(want) > 0 {
+			i := rng.Intn(len(want))
+			if got, _ := d.At(i); got != want[i] {
+				t.Fatalf("step %d: At(%d) = %d, want %d", step, i, got, want[i])
+			}
+		}
+	}
+}
+
+func TestQueueFIFO(t *testing.T) {
+	var q Queue[string]
+	for _, s := range []string{"a", "b", "c"} {
+		q.Enqueue(s)
+	}
+	if front, _ := q.Front(); front != "a" {
+		t.Fatalf("Front() = %q, want a", front)
+	}
+	for _, want := range []string{"a", "b", "c"} {
+		if got, err := q.Dequeue(); err != nil || got != want {
+			t.Fatalf("Dequeue() = %q, %v; want %q", got, err, want)
+		}
+	}
+	if _, err := q.Dequeue(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("Dequeue on empty queue: err = %v, want ErrEmpty", err)
+	}
+}
+
+// sliceQueue is the queue as it was before the ring buffer: Dequeue
+// reslices, so the backing array is only released when append copies it
+type sliceQueue[T any] struct {
+	items []T
+}
+
+func (q *sliceQueue[T]) Enqueue(item T) {
+	q.items = append(q.items, item)
+}
+
+func (q *sliceQueue[T]) Dequeue() (T, error) {
+	if len(q.items) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	item := q.items[0]
+	q.items = q.items[1:]
+	return item, nil
+}
+
+// BenchmarkQueue compares the ring-buffer Queue against the slice-shifting
+// one on a steady stream through a short queue, as in a long-running
+// consumer, and on filling and draining a long queue
+func BenchmarkQueue(b *testing.B) {
+	steady := func(b *testing.B, enqueue func(int), dequeue func() (int, error)) {
+		b.ReportAllocs()
+		for i := range 16 {
+			enqueue(i)
+		}
+		for i := range b.N {
+			enqueue(i)
+			dequeue()
+		}
+	}
+	burst := func(b *testing.B, enqueue func(int), dequeue func() (int, error)) {
+		b.ReportAllocs()
+		for range b.N {
+			for i := range 4096 {
+				enqueue(i)
+			}
+			for range 4096 {
+				dequeue()
+			}
+		}
+	}
+	b.Run("steady/ring", func(b *testing.B) {
+		var q Queue[int]
+		steady(b, q.Enqueue, q.Dequeue)
+	})
+	b.Run("steady/slice", func(b *testing.B) {
+		var q sliceQueue[int]
+		steady(b, q.Enqueue, q.Dequeue)
+	})
+	b.Run("burst/ring", func(b *testing.B) {
+		var q Queue[int]
+		burst(b, q.Enqueue, q.Dequeue)
+	})
+	b.Run("burst/slice", func(b *testing.B) {
+		var q sliceQueue[int]
+		burst(b, q.Enqueue, q.Dequeue)
+	})
+}
@@ -0,0 +1,157 @@
+package expression
+
+import (
+	"math"
+
+	stackqueue "github.com/kuldeep-bishnoi/Golang-DSA/10_Stacks_Queues"
+)
+
+// Function is a user-registered function. Arity is the number of arguments
+// it accepts, or -1 for any number.
+type Function struct {
+	Arity int
+	Call  func(args []float64) (float64, error)
+}
+
+// Env holds the variables and functions an expression may refer to.
+// The zero value is an empty environment ready to use.
+type Env struct {
+	vars  map[string]float64
+	funcs map[string]Function
+}
+
+// SetVar binds a variable name to a value
+func (env *Env) SetVar(name string, value float64) {
+	if env.vars == nil {
+		env.vars = make(map[string]float64)
+	}
+	env.vars[name] = value
+}
+
+// Register makes a function callable from expressions under the given name
+func (env *Env) Register(name string, arity int, call func(args []float64) (float64, error)) {
+	if env.funcs == nil {
+		env.funcs = make(map[string]Function)
+	}
+	env.funcs[name] = Function{Arity: arity, Call: call}
+}
+
+// Evaluate tokenizes, parses and evaluates an infix expression.
+// env may be nil if the expression uses no variables or functions.
+func Evaluate(input string, env *Env) (float64, error) {
+	tokens, err := Tokenize(input)
+	if err != nil {
+		return 0, err
+	}
+	postfix, err := ToPostfix(tokens)
+	if err != nil {
+		return 0, err
+	}
+	return EvalPostfix(postfix, env)
+}
+
+// EvalPostfix evaluates tokens in the postfix order produced by ToPostfix:
+// operands are pushed on a stack and every operator or function call
+// replaces its arguments on top of the stack with its result
+func EvalPostfix(postfix []Token, env *Env) (float64, error) {
+	if env == nil {
+		env = &Env{}
+	}
+	values := &stackqueue.Stack[float64]{}
+	for _, tok := range postfix {
+		switch {
+		case tok.Kind == Number:
+			values.Push(tok.Value)
+
+		case tok.Kind == Identifier && !tok.Call:
+			value, ok := env.vars[tok.Text]
+			if !ok {
+				return 0, errorAt(tok, "unknown variable")
+			}
+			values.Push(value)
+
+		case tok.Kind == Identifier:
+			fn, ok := env.funcs[tok.Text]
+			if !ok {
+				return 0, errorAt(tok, "unknown function")
+			}
+			if fn.Arity >= 0 && fn.Arity != tok.Args {
+				return 0, errorAt(tok, "function takes %d arguments, got %d", fn.Arity, tok.Args)
+			}
+			args, err := popN(values, tok.Args)
+			if err != nil {
+				return 0, errorAt(tok, "missing function arguments")
+			}
+			result, err := fn.Call(args)
+			if err != nil {
+				return 0, errorAt(tok, "%v", err)
+			}
+			values.Push(result)
+
+		case tok.Kind == Operator && tok.Unary:
+			operand, err := values.Pop()
+			if err != nil {
+				return 0, errorAt(tok, "operator is missing its operand")
+			}
+			if tok.Text == "-" {
+				operand = -operand
+			}
+			values.Push(operand)
+
+		case tok.Kind == Operator:
+			operands, err := popN(values, 2)
+			if err != nil {
+				return 0, errorAt(tok, "operator is missing its operands")
+			}
+			result, err := apply(tok, operands[0], operands[1])
+			if err != nil {
+				return 0, err
+			}
+			values.Push(result)
+
+		default:
+			return 0, errorAt(tok, "unexpected %s in postfix expression", tok.Kind)
+		}
+	}
+	if values.Len() != 1 {
+		return 0, &Error{Msg: "malformed postfix expression"}
+	}
+	result, _ := values.Pop()
+	return result, nil
+}
+
+func apply(op Token, a, b float64) (float64, error) {
+	switch op.Text {
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	case "/":
+		if b == 0 {
+			return 0, errorAt(op, "division by zero")
+		}
+		return a / b, nil
+	case "%":
+		if b == 0 {
+			return 0, errorAt(op, "division by zero")
+		}
+		return math.Mod(a, b), nil
+	case "^":
+		return math.Pow(a, b), nil
+	}
+	return 0, errorAt(op, "unknown operator")
+}
+
+// popN pops n values and returns them in the order they were pushed
+func popN(values *stackqueue.Stack[float64], n int) ([]float64, error) {
+	if values.Len() < n {
+		return nil, stackqueue.ErrEmpty
+	}
+	args := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		args[i], _ = values.Pop()
+	}
+	return args, nil
+}
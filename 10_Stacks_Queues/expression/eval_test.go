@@ -0,0 +1,112 @@
+package expression
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func testEnv() *Env {
+	env := &Env{}
+	env.SetVar("x", 3)
+	env.SetVar("y", 4)
+	env.Register("hypot", 2, func(args []float64) (float64, error) {
+		return math.Hypot(args[0], args[1]), nil
+	})
+	env.Register("sum", -1, func(args []float64) (float64, error) {
+		total := 0.0
+		for _, arg := range args {
+			total += arg
+		}
+		return total, nil
+	})
+	env.Register("sqrt", 1, func(args []float64) (float64, error) {
+		if args[0] < 0 {
+			return 0, errors.New("negative argument")
+		}
+		return math.Sqrt(args[0]), nil
+	})
+	return env
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		input string
+		want  float64
+	}{
+		{"1 + 2 * 3", 7},
+		{"(1 + 2) * 3", 9},
+		{"1 - 2 - 3", -4},
+		{"8 / 4 / 2", 1},
+		{"2 ^ 3 ^ 2", 512},
+		{"-2 ^ 2", -4},
+		{"2 ^ -1", 0.5},
+		{"-3 * -2", 6},
+		{"+4", 4},
+		{"7 % 3", 1},
+		{"-7 % 3", -1},
+		{"1e3 + 2.5E-1", 1000.25},
+		{".5 * 4", 2},
+		{"x * y", 12},
+		{"hypot(x, y)", 5},
+		{"sum()", 0},
+		{"sum(1, 2, 3, x)", 9},
+		{"sqrt(sum(x*x, y*y)) + 1", 6},
+		{"-hypot(3, 4) ^ 2", -25},
+	}
+	env := testEnv()
+	for _, tt := range tests {
+		got, err := Evaluate(tt.input, env)
+		if err != nil || got != tt.want {
+			t.Errorf("Evaluate(%q) = %v, %v; want %v", tt.input, got, err, tt.want)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{"1 / 0", 2, "division by zero"},
+		{"1 % (2 - 2)", 2, "division by zero"},
+		{"z + 1", 0, "unknown variable"},
+		{"1 + nope(2)", 4, "unknown function"},
+		{"hypot(1)", 0, "function takes 2 arguments, got 1"},
+		{"sqrt(1, 2)", 0, "function takes 1 arguments, got 2"},
+		{"sqrt(-1)", 0, "negative argument"},
+	}
+	env := testEnv()
+	for _, tt := range tests {
+		_, err := Evaluate(tt.input, env)
+		var exprErr *Error
+		if !errors.As(err, &exprErr) || exprErr.Pos != tt.pos || exprErr.Msg != tt.msg {
+			t.Errorf("Evaluate(%q): err = %v, want %q at %d", tt.input, err, tt.msg, tt.pos)
+		}
+	}
+	// a nil environment knows no variables
+	if _, err := Evaluate("x", nil); err == nil {
+		t.Error("Evaluate(x) with a nil environment succeeded")
+	}
+}
+
+func TestEvalPostfixRejectsMalformedInput(t *testing.T) {
+	num := func(v float64) Token { return Token{Kind: Number, Value: v} }
+	plus := Token{Kind: Operator, Text: "+"}
+	tests := []struct {
+		name    string
+		postfix []Token
+	}{
+		{"empty", nil},
+		{"two operands left over", []Token{num(1), num(2)}},
+		{"operator without operands", []Token{num(1), plus}},
+		{"unary without operand", []Token{{Kind: Operator, Text: "-", Unary: true}}},
+		{"parenthesis", []Token{num(1), {Kind: LeftParen, Text: "("}}},
+	}
+	for _, tt := range tests {
+		if _, err := EvalPostfix(tt.postfix, nil); err == nil {
+			t.Errorf("%s: EvalPostfix succeeded", tt.name)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package expression
+
+import stackqueue "github.com/kuldeep-bishnoi/Golang-DSA/10_Stacks_Queues"
+
+// Binding strengths: unary minus binds tighter than * but looser than ^,
+// so -2^2 is -(2^2) and 2^-1 is 2^(-1)
+const (
+	precAdditive = iota + 1
+	precMultiplicative
+	precUnary
+	precPower
+)
+
+func precedence(tok Token) int {
+	if tok.Unary {
+		return precUnary
+	}
+	switch tok.Text {
+	case "+", "-":
+		return precAdditive
+	case "*", "/", "%":
+		return precMultiplicative
+	case "^":
+		return precPower
+	}
+	return 0
+}
+
+func rightAssociative(tok Token) bool {
+	return tok.Unary || tok.Text == "^"
+}
+
+// ToPostfix reorders infix tokens into postfix (reverse Polish) order using
+// Dijkstra's shunting-yard algorithm. Operators wait on a stack until an
+// operator of lower precedence, a closing parenthesis or the end of input
+// pushes them to the output.
+//
+// Prefix + and - are marked Unary, identifiers followed by ( are marked Call
+// with their argument count in Args, and grouping parentheses are dropped.
+func ToPostfix(tokens []Token) ([]Token, error) {
+	var output []Token
+	operators := &stackqueue.Stack[Token]{}
+	// One entry per open parenthesis: the number of arguments seen so far
+	// for a function call, or -1 for a grouping parenthesis
+	args := &stackqueue.Stack[int]{}
+	expectOperand := true
+
+	for i, tok := range tokens {
+		switch tok.Kind {
+		case Number:
+			if !expectOperand {
+				return nil, errorAt(tok, "unexpected number")
+			}
+			output = append(output, tok)
+			expectOperand = false
+
+		case Identifier:
+			if !expectOperand {
+				return nil, errorAt(tok, "unexpected identifier")
+			}
+			if i+1 < len(tokens) && tokens[i+1].Kind == LeftParen {
+				tok.Call = true
+				operators.Push(tok)
+				continue
+			}
+			output = append(output, tok)
+			expectOperand = false
+
+		case Operator:
+			if expectOperand {
+				if tok.Text != "-" && tok.Text != "+" {
+					return nil, errorAt(tok, "operator is missing its left operand")
+				}
+				tok.Unary = true
+				operators.Push(tok)
+				continue
+			}
+			for !operators.IsEmpty() {
+				top, _ := operators.Peek()
+				if top.Kind != Operator {
+					break
+				}
+				if precedence(top) < precedence(tok) || (precedence(top) == precedence(tok) && rightAssociative(tok)) {
+					break
+				}
+				operators.Pop()
+				output = append(output, top)
+			}
+			operators.Push(tok)
+			expectOperand = true
+
+		case LeftParen:
+			if !expectOperand {
+				return nil, errorAt(tok, "unexpected '('")
+			}
+			if top, err := operators.Peek(); err == nil && top.Kind == Identifier && top.Call {
+				args.Push(1)
+			} else {
+				args.Push(-1)
+			}
+			operators.Push(tok)
+
+		case Comma:
+			count, err := args.Peek()
+			if err != nil || count < 0 {
+				return nil, errorAt(tok, "comma outside of a function call")
+			}
+			if expectOperand {
+				return nil, errorAt(tok, "missing function argument")
+			}
+			if output, err = popUntilParen(operators, output); err != nil {
+				return nil, errorAt(tok, "comma outside of a function call")
+			}
+			args.Pop()
+			args.Push(count + 1)
+			expectOperand = true
+
+		case RightParen:
+			count, err := args.Pop()
+			if err != nil {
+				return nil, errorAt(tok, "unmatched ')'")
+			}
+			if expectOperand {
+				// Only an empty argument list may close right after its '('
+				if i == 0 || tokens[i-1].Kind != LeftParen || count < 0 {
+					return nil, errorAt(tok, "missing operand before ')'")
+				}
+				count = 0
+			}
+			if output, err = popUntilParen(operators, output); err != nil {
+				return nil, errorAt(tok, "unmatched ')'")
+			}
+			operators.Pop()
+			if count >= 0 {
+				call, _ := operators.Pop()
+				call.Args = count
+				output = append(output, call)
+			}
+			expectOperand = false
+		}
+	}
+
+	if expectOperand {
+		end := 0
+		if len(tokens) > 0 {
+			last := tokens[len(tokens)-1]
+			end = last.Pos + len(last.Text)
+		}
+		return nil, &Error{Pos: end, Msg: "unexpected end of expression"}
+	}
+	for !operators.IsEmpty() {
+		top, _ := operators.Pop()
+		if top.Kind == LeftParen {
+			return nil, errorAt(top, "unmatched '('")
+		}
+		output = append(output, top)
+	}
+	return output, nil
+}
+
+// popUntilParen moves operators to the output until the innermost '(' is on
+// top of the stack, leaving it there
+func popUntilParen(operators *stackqueue.Stack[Token], output []Token) ([]Token, error) {
+	for {
+		top, err := operators.Peek()
+		if err != nil {
+			return output, err
+		}
+		if top.Kind == LeftParen {
+			return output, nil
+		}
+		operators.Pop()
+		output = append(output, top)
+	}
+}
@@ -0,0 +1,108 @@
+package expression
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// postfixString renders postfix tokens with unary operators written as u-
+// and u+ and calls as name/argc, so the tests can compare plain strings
+func postfixString(tokens []Token) string {
+	parts := make([]string, len(tokens))
+	for i, tok := range tokens {
+		switch {
+		case tok.Unary:
+			parts[i] = "u" + tok.Text
+		case tok.Call:
+			parts[i] = tok.Text + "/" + strconv.Itoa(tok.Args)
+		default:
+			parts[i] = tok.Text
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestToPostfix(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		// precedence
+		{"1+2*3", "1 2 3 * +"},
+		{"1*2+3", "1 2 * 3 +"},
+		{"(1+2)*3", "1 2 + 3 *"},
+		{"a % b * c", "a b % c *"},
+		{"2*(3+4)^2", "2 3 4 + 2 ^ *"},
+		// associativity: ^ groups to the right, the rest to the left
+		{"1-2-3", "1 2 - 3 -"},
+		{"8/4/2", "8 4 / 2 /"},
+		{"2^3^2", "2 3 2 ^ ^"},
+		// unary minus binds tighter than * but looser than ^
+		{"-3*2", "3 u- 2 *"},
+		{"-2^2", "2 2 ^ u-"},
+		{"2^-1", "2 1 u- ^"},
+		{"--1", "1 u- u-"},
+		{"+5", "5 u+"},
+		{"1--1", "1 1 u- -"},
+		// function calls
+		{"f()", "f/0"},
+		{"f(x)", "x f/1"},
+		{"max(1, 2+3, f(4))", "1 2 3 + 4 f/1 max/3"},
+		{"-f(1)^2", "1 f/1 2 ^ u-"},
+		{"((1))", "1"},
+	}
+	for _, tt := range tests {
+		tokens, err := Tokenize(tt.input)
+		if err != nil {
+			t.Fatalf("Tokenize(%q): %v", tt.input, err)
+		}
+		postfix, err := ToPostfix(tokens)
+		if err != nil {
+			t.Errorf("ToPostfix(%q): %v", tt.input, err)
+			continue
+		}
+		if got := postfixString(postfix); got != tt.want {
+			t.Errorf("ToPostfix(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestSyntaxErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{"", 0, "unexpected end of expression"},
+		{"1 +", 3, "unexpected end of expression"},
+		{"f(", 2, "unexpected end of expression"},
+		{"(1+2", 0, "unmatched '('"},
+		{"1+2)", 3, "unmatched ')'"},
+		{"* 2", 0, "operator is missing its left operand"},
+		{"1 2", 2, "unexpected number"},
+		{"2x", 1, "unexpected identifier"},
+		{"1(2)", 1, "unexpected '('"},
+		{"f(,1)", 2, "missing function argument"},
+		{"f(1,)", 4, "missing operand before ')'"},
+		{"()", 1, "missing operand before ')'"},
+		{"1,2", 1, "comma outside of a function call"},
+		{"(1,2)", 2, "comma outside of a function call"},
+		// tokenizer errors
+		{"1 # 2", 2, "unexpected character"},
+		{"1.2.3", 0, "invalid number"},
+		{".", 0, "invalid number"},
+	}
+	for _, tt := range tests {
+		_, err := Evaluate(tt.input, nil)
+		var exprErr *Error
+		if !errors.As(err, &exprErr) {
+			t.Errorf("Evaluate(%q): err = %v, want an *Error", tt.input, err)
+			continue
+		}
+		if exprErr.Pos != tt.pos || exprErr.Msg != tt.msg {
+			t.Errorf("Evaluate(%q): error %q at %d, want %q at %d", tt.input, exprErr.Msg, exprErr.Pos, tt.msg, tt.pos)
+		}
+	}
+}
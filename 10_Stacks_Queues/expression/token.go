@@ -0,0 +1,138 @@
+package expression
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Kind identifies what a token represents
+type Kind int
+
+const (
+	Number     Kind = iota // numeric literal such as 3 or 2.5e3
+	Identifier             // name of a variable or function
+	Operator               // one of + - * / % ^
+	LeftParen              // (
+	RightParen             // )
+	Comma                  // separates function arguments
+)
+
+// String returns a readable name for the kind
+func (k Kind) String() string {
+	switch k {
+	case Number:
+		return "number"
+	case Identifier:
+		return "identifier"
+	case Operator:
+		return "operator"
+	case LeftParen:
+		return "'('"
+	case RightParen:
+		return "')'"
+	case Comma:
+		return "','"
+	}
+	return "unknown"
+}
+
+// Token is a lexical unit of an expression. Pos is the byte offset of the
+// token in the input, so errors can point at it.
+type Token struct {
+	Kind  Kind
+	Text  string
+	Pos   int
+	Value float64 // parsed value of a Number token
+
+	// Set by ToPostfix: Unary marks a prefix minus or plus, Call marks an
+	// identifier used as a function name and Args is its argument count
+	Unary bool
+	Call  bool
+	Args  int
+}
+
+// Error describes a problem with an expression and where it was found
+type Error struct {
+	Pos   int    // byte offset of the offending token
+	Token string // text of the offending token, empty at end of input
+	Msg   string
+}
+
+func (e *Error) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("expression: %s at position %d", e.Msg, e.Pos)
+	}
+	return fmt.Sprintf("expression: %s at position %d (%q)", e.Msg, e.Pos, e.Token)
+}
+
+func errorAt(tok Token, format string, args ...any) *Error {
+	return &Error{Pos: tok.Pos, Token: tok.Text, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Tokenize splits input into numbers, identifiers, operators, parentheses and commas
+func Tokenize(input string) ([]Token, error) {
+	var tokens []Token
+	i := 0
+	for i < len(input) {
+		c := rune(input[i])
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isDigit(c) || c == '.':
+			start := i
+			for i < len(input) && (isDigit(rune(input[i])) || input[i] == '.') {
+				i++
+			}
+			if i < len(input) && (input[i] == 'e' || input[i] == 'E') {
+				j := i + 1
+				if j < len(input) && (input[j] == '+' || input[j] == '-') {
+					j++
+				}
+				if j < len(input) && isDigit(rune(input[j])) {
+					for i = j; i < len(input) && isDigit(rune(input[i])); i++ {
+					}
+				}
+			}
+			text := input[start:i]
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, &Error{Pos: start, Token: text, Msg: "invalid number"}
+			}
+			tokens = append(tokens, Token{Kind: Number, Text: text, Pos: start, Value: value})
+		case isIdentStart(c):
+			start := i
+			for i < len(input) && (isIdentStart(rune(input[i])) || isDigit(rune(input[i]))) {
+				i++
+			}
+			tokens = append(tokens, Token{Kind: Identifier, Text: input[start:i], Pos: start})
+		default:
+			kind, ok := punctuation[c]
+			if !ok {
+				return nil, &Error{Pos: i, Token: string(c), Msg: "unexpected character"}
+			}
+			tokens = append(tokens, Token{Kind: kind, Text: string(c), Pos: i})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+var punctuation = map[rune]Kind{
+	'+': Operator,
+	'-': Operator,
+	'*': Operator,
+	'/': Operator,
+	'%': Operator,
+	'^': Operator,
+	'(': LeftParen,
+	')': RightParen,
+	',': Comma,
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c rune) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,70 @@
+package expression
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens, err := Tokenize(" max(x1, -2.5e3)\t^_y ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Token{
+		{Kind: Identifier, Text: "max", Pos: 1},
+		{Kind: LeftParen, Text: "(", Pos: 4},
+		{Kind: Identifier, Text: "x1", Pos: 5},
+		{Kind: Comma, Text: ",", Pos: 7},
+		{Kind: Operator, Text: "-", Pos: 9},
+		{Kind: Number, Text: "2.5e3", Pos: 10, Value: 2500},
+		{Kind: RightParen, Text: ")", Pos: 15},
+		{Kind: Operator, Text: "^", Pos: 17},
+		{Kind: Identifier, Text: "_y", Pos: 18},
+	}
+	if len(tokens) != len(want) {
+		t.Fatalf("Tokenize returned %d tokens, want %d: %v", len(tokens), len(want), tokens)
+	}
+	for i := range want {
+		if tokens[i] != want[i] {
+			t.Errorf("token %d = %+v, want %+v", i, tokens[i], want[i])
+		}
+	}
+}
+
+func TestTokenizeExponents(t *testing.T) {
+	tests := []struct {
+		input string
+		texts []string
+	}{
+		{"1e5", []string{"1e5"}},
+		{"1E+5", []string{"1E+5"}},
+		// an e that does not start an exponent is an identifier
+		{"2e", []string{"2", "e"}},
+		{"2e+", []string{"2", "e", "+"}},
+	}
+	for _, tt := range tests {
+		tokens, err := Tokenize(tt.input)
+		if err != nil {
+			t.Errorf("Tokenize(%q): %v", tt.input, err)
+			continue
+		}
+		var texts []string
+		for _, tok := range tokens {
+			texts = append(texts, tok.Text)
+		}
+		if !slices.Equal(texts, tt.texts) {
+			t.Errorf("Tokenize(%q) = %q, want %q", tt.input, texts, tt.texts)
+		}
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	err := &Error{Pos: 3, Token: ")", Msg: "unmatched ')'"}
+	if got, want := err.Error(), `expression: unmatched ')' at position 3 (")")`; got != want {
+		t.Errorf("Error() = %s, want %s", got, want)
+	}
+	err = &Error{Pos: 5, Msg: "unexpected end of expression"}
+	if got, want := err.Error(), "expression: unexpected end of expression at position 5"; got != want {
+		t.Errorf("Error() = %s, want %s", got, want)
+	}
+}
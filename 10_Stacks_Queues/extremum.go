@@ -0,0 +1,175 @@
+package stackqueue
+
+import "cmp"
+
+// extremum pairs a stack item with the best item at or below it
+type extremum[T any] struct {
+	value T
+	best  T
+}
+
+// extremeStack is the shared implementation of MinStack and MaxStack: every
+// entry remembers the best value in the stack up to that point, so the
+// current best is always on top.
+type extremeStack[T cmp.Ordered] struct {
+	items Stack[extremum[T]]
+}
+
+func (s *extremeStack[T]) push(item T, better func(a, b T) bool) {
+	best := item
+	if top, err := s.items.Peek(); err == nil && better(top.best, item) {
+		best = top.best
+	}
+	s.items.Push(extremum[T]{value: item, best: best})
+}
+
+func (s *extremeStack[T]) pop() (T, error) {
+	top, err := s.items.Pop()
+	return top.value, err
+}
+
+func (s *extremeStack[T]) peek() (T, error) {
+	top, err := s.items.Peek()
+	return top.value, err
+}
+
+func (s *extremeStack[T]) best() (T, error) {
+	top, err := s.items.Peek()
+	return top.best, err
+}
+
+func less[T cmp.Ordered](a, b T) bool {
+	return a < b
+}
+
+func greater[T cmp.Ordered](a, b T) bool {
+	return a > b
+}
+
+// MinStack is a stack that also reports its smallest item in O(1)
+type MinStack[T cmp.Ordered] struct {
+	stack extremeStack[T]
+}
+
+// Push adds an item to the stack
+func (s *MinStack[T]) Push(item T) {
+	s.stack.push(item, less[T])
+}
+
+// Pop removes and returns the top item from the stack
+func (s *MinStack[T]) Pop() (T, error) {
+	return s.stack.pop()
+}
+
+// Peek returns the top item from the stack without removing it
+func (s *MinStack[T]) Peek() (T, error) {
+	return s.stack.peek()
+}
+
+// Min returns the smallest item in the stack
+func (s *MinStack[T]) Min() (T, error) {
+	return s.stack.best()
+}
+
+// Len returns the number of items in the stack
+func (s *MinStack[T]) Len() int {
+	return s.stack.items.Len()
+}
+
+// IsEmpty reports whether the stack has no items
+func (s *MinStack[T]) IsEmpty() bool {
+	return s.stack.items.IsEmpty()
+}
+
+// MaxStack is a stack that also reports its largest item in O(1)
+type MaxStack[T cmp.Ordered] struct {
+	stack extremeStack[T]
+}
+
+// Push adds an item to the stack
+func (s *MaxStack[T]) Push(item T) {
+	s.stack.push(item, greater[T])
+}
+
+// Pop removes and returns the top item from the stack
+func (s *MaxStack[T]) Pop() (T, error) {
+	return s.stack.pop()
+}
+
+// Peek returns the top item from the stack without removing it
+func (s *MaxStack[T]) Peek() (T, error) {
+	return s.stack.peek()
+}
+
+// Max returns the largest item in the stack
+func (s *MaxStack[T]) Max() (T, error) {
+	return s.stack.best()
+}
+
+// Len returns the number of items in the stack
+func (s *MaxStack[T]) Len() int {
+	return s.stack.items.Len()
+}
+
+// IsEmpty reports whether the stack has no items
+func (s *MaxStack[T]) IsEmpty() bool {
+	return s.stack.items.IsEmpty()
+}
+
+// MaxQueue is a queue that also reports its largest item in amortized O(1).
+// It is QueueFromStacks built on two MaxStacks: the largest item in the
+// queue is the larger of the two stacks' maxima.
+type MaxQueue[T cmp.Ordered] struct {
+	inbox  MaxStack[T]
+	outbox MaxStack[T]
+}
+
+// Enqueue adds an item to the queue
+func (q *MaxQueue[T]) Enqueue(item T) {
+	q.inbox.Push(item)
+}
+
+// Dequeue removes and returns the front item from the queue
+func (q *MaxQueue[T]) Dequeue() (T, error) {
+	q.refill()
+	return q.outbox.Pop()
+}
+
+// Front returns the front item from the queue without removing it
+func (q *MaxQueue[T]) Front() (T, error) {
+	q.refill()
+	return q.outbox.Peek()
+}
+
+// Max returns the largest item in the queue
+func (q *MaxQueue[T]) Max() (T, error) {
+	inMax, inErr := q.inbox.Max()
+	outMax, outErr := q.outbox.Max()
+	switch {
+	case inErr != nil:
+		return outMax, outErr
+	case outErr != nil:
+		return inMax, nil
+	}
+	return max(inMax, outMax), nil
+}
+
+// Len returns the number of items in the queue
+func (q *MaxQueue[T]) Len() int {
+	return q.inbox.Len() + q.outbox.Len()
+}
+
+// IsEmpty reports whether the queue has no items
+func (q *MaxQueue[T]) IsEmpty() bool {
+	return q.Len() == 0
+}
+
+func (q *MaxQueue[T]) refill() {
+	if !q.outbox.IsEmpty() {
+		return
+	}
+	for !q.inbox.IsEmpty() {
+		item, _ := q.inbox.Pop()
+		q.outbox.Push(item)
+	}
+}
@@ -0,0 +1,130 @@
+package stackqueue
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// composite is the view of a stack or queue that the oracle drives. best is
+// nil for the types that do not track an extremum.
+type composite struct {
+	put  func(int)
+	take func() (int, error)
+	peek func() (int, error)
+	best func() (int, error)
+	len  func() int
+	lifo bool
+	want func([]int) int // the extremum the oracle finds by scanning
+}
+
+func checkAgainstSlice(t *testing.T, name string, c composite) {
+	t.Helper()
+	rng := rand.New(rand.NewSource(1))
+	var model []int
+	for step := range 5000 {
+		// bias towards putting so the structure grows deep enough to matter
+		if rng.Intn(5) < 3 {
+			v := rng.Intn(50)
+			c.put(v)
+			model = append(model, v)
+		} else {
+			got, err := c.take()
+			if len(model) == 0 {
+				if !errors.Is(err, ErrEmpty) {
+					t.Fatalf("%s step %d: take on empty: err = %v, want ErrEmpty", name, step, err)
+				}
+				continue
+			}
+			var want int
+			if c.lifo {
+				want, model = model[len(model)-1], model[:len(model)-1]
+			} else {
+				want, model = model[0], model[1:]
+			}
+			if err != nil || got != want {
+				t.Fatalf("%s step %d: take() = %d, %v; want %d", name, step, got, err, want)
+			}
+		}
+
+		if c.len() != len(model) {
+			t.Fatalf("%s step %d: Len() = %d, want %d", name, step, c.len(), len(model))
+		}
+		if len(model) == 0 {
+			if _, err := c.peek(); !errors.Is(err, ErrEmpty) {
+				t.Fatalf("%s step %d: peek on empty: err = %v, want ErrEmpty", name, step, err)
+			}
+			if c.best != nil {
+				if _, err := c.best(); !errors.Is(err, ErrEmpty) {
+					t.Fatalf("%s step %d: extremum of empty: err = %v, want ErrEmpty", name, step, err)
+				}
+			}
+			continue
+		}
+		want := model[0]
+		if c.lifo {
+			want = model[len(model)-1]
+		}
+		if got, err := c.peek(); err != nil || got != want {
+			t.Fatalf("%s step %d: peek() = %d, %v; want %d", name, step, got, err, want)
+		}
+		if c.best != nil {
+			if got, err := c.best(); err != nil || got != c.want(model) {
+				t.Fatalf("%s step %d: extremum = %d, %v; want %d", name, step, got, err, c.want(model))
+			}
+		}
+	}
+}
+
+func TestCompositesAgainstSlice(t *testing.T) {
+	var minStack MinStack[int]
+	checkAgainstSlice(t, "MinStack", composite{
+		put: minStack.Push, take: minStack.Pop, peek: minStack.Peek, best: minStack.Min,
+		len: minStack.Len, lifo: true, want: slices.Min[[]int],
+	})
+	var maxStack MaxStack[int]
+	checkAgainstSlice(t, "MaxStack", composite{
+		put: maxStack.Push, take: maxStack.Pop, peek: maxStack.Peek, best: maxStack.Max,
+		len: maxStack.Len, lifo: true, want: slices.Max[[]int],
+	})
+	var maxQueue MaxQueue[int]
+	checkAgainstSlice(t, "MaxQueue", composite{
+		put: maxQueue.Enqueue, take: maxQueue.Dequeue, peek: maxQueue.Front, best: maxQueue.Max,
+		len: maxQueue.Len, want: slices.Max[[]int],
+	})
+	var viaStacks QueueFromStacks[int]
+	checkAgainstSlice(t, "QueueFromStacks", composite{
+		put: viaStacks.Enqueue, take: viaStacks.Dequeue, peek: viaStacks.Front, len: viaStacks.Len,
+	})
+	var viaQueues StackFromQueues[int]
+	checkAgainstSlice(t, "StackFromQueues", composite{
+		put: viaQueues.Push, take: viaQueues.Pop, peek: viaQueues.Peek, len: viaQueues.Len, lifo: true,
+	})
+}
+
+func TestExtremumWithDuplicates(t *testing.T) {
+	// popping one copy of the extremum must leave the other in place
+	var s MinStack[int]
+	for _, v := range []int{3, 1, 1, 2} {
+		s.Push(v)
+	}
+	s.Pop()
+	s.Pop()
+	if got, _ := s.Min(); got != 1 {
+		t.Fatalf("Min() = %d after popping one of two 1s, want 1", got)
+	}
+
+	var q MaxQueue[int]
+	for _, v := range []int{5, 5, 2} {
+		q.Enqueue(v)
+	}
+	q.Dequeue()
+	if got, _ := q.Max(); got != 5 {
+		t.Fatalf("Max() = %d after dequeuing one of two 5s, want 5", got)
+	}
+	q.Dequeue()
+	if got, _ := q.Max(); got != 2 {
+		t.Fatalf("Max() = %d after dequeuing both 5s, want 2", got)
+	}
+}
@@ -0,0 +1,31 @@
+package stackqueue
+
+// LIFO is the set of operations shared by every stack in this package
+type LIFO[T any] interface {
+	Push(item T)
+	Pop() (T, error)
+	Peek() (T, error)
+	Len() int
+	IsEmpty() bool
+}
+
+// FIFO is the set of operations shared by every queue in this package
+type FIFO[T any] interface {
+	Enqueue(item T)
+	Dequeue() (T, error)
+	Front() (T, error)
+	Len() int
+	IsEmpty() bool
+}
+
+var (
+	_ LIFO[int] = (*Stack[int])(nil)
+	_ LIFO[int] = (*LockFreeStack[int])(nil)
+	_ LIFO[int] = (*StackFromQueues[int])(nil)
+	_ LIFO[int] = (*MinStack[int])(nil)
+	_ LIFO[int] = (*MaxStack[int])(nil)
+	_ FIFO[int] = (*Queue[int])(nil)
+	_ FIFO[int] = (*LockFreeQueue[int])(nil)
+	_ FIFO[int] = (*QueueFromStacks[int])(nil)
+	_ FIFO[int] = (*MaxQueue[int])(nil)
+)
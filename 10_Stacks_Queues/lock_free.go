@@ -0,0 +1,158 @@
+package stackqueue
+
+import "sync/atomic"
+
+// LockFreeStack is a Treiber stack: a singly linked list whose top pointer is
+// swapped with compare-and-swap, so concurrent pushers and poppers never block
+// each other. The zero value is an empty stack ready to use.
+type LockFreeStack[T any] struct {
+	top  atomic.Pointer[stackNode[T]]
+	size atomic.Int64
+}
+
+type stackNode[T any] struct {
+	value T
+	next  *stackNode[T]
+}
+
+// Push adds an item to the stack
+func (s *LockFreeStack[T]) Push(item T) {
+	node := &stackNode[T]{value: item}
+	for {
+		top := s.top.Load()
+		node.next = top
+		if s.top.CompareAndSwap(top, node) {
+			s.size.Add(1)
+			return
+		}
+	}
+}
+
+// Pop removes and returns the top item from the stack
+func (s *LockFreeStack[T]) Pop() (T, error) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			var zero T
+			return zero, ErrEmpty
+		}
+		if s.top.CompareAndSwap(top, top.next) {
+			s.size.Add(-1)
+			return top.value, nil
+		}
+	}
+}
+
+// Peek returns the top item from the stack without removing it
+func (s *LockFreeStack[T]) Peek() (T, error) {
+	top := s.top.Load()
+	if top == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return top.value, nil
+}
+
+// Len returns the number of items in the stack; while other goroutines are
+// pushing or popping it is only a snapshot
+func (s *LockFreeStack[T]) Len() int {
+	return max(int(s.size.Load()), 0)
+}
+
+// IsEmpty reports whether the stack has no items
+func (s *LockFreeStack[T]) IsEmpty() bool {
+	return s.top.Load() == nil
+}
+
+// LockFreeQueue is a Michael-Scott queue: a singly linked list with a dummy
+// head node where enqueuers and dequeuers advance the tail and head pointers
+// with compare-and-swap, helping each other finish a half-done enqueue.
+// Use NewLockFreeQueue to create one.
+type LockFreeQueue[T any] struct {
+	head atomic.Pointer[queueNode[T]]
+	tail atomic.Pointer[queueNode[T]]
+	size atomic.Int64
+}
+
+type queueNode[T any] struct {
+	value T
+	next  atomic.Pointer[queueNode[T]]
+}
+
+// NewLockFreeQueue creates an empty lock-free queue
+func NewLockFreeQueue[T any]() *LockFreeQueue[T] {
+	q := &LockFreeQueue[T]{}
+	dummy := &queueNode[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// Enqueue adds an item to the queue
+func (q *LockFreeQueue[T]) Enqueue(item T) {
+	node := &queueNode[T]{value: item}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// Another enqueue linked its node but has not swung the tail yet
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, node) {
+			q.tail.CompareAndSwap(tail, node)
+			q.size.Add(1)
+			return
+		}
+	}
+}
+
+// Dequeue removes and returns the front item from the queue
+func (q *LockFreeQueue[T]) Dequeue() (T, error) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			var zero T
+			return zero, ErrEmpty
+		}
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		// next becomes the new dummy node; its value is handed to the caller
+		value := next.value
+		if q.head.CompareAndSwap(head, next) {
+			q.size.Add(-1)
+			return value, nil
+		}
+	}
+}
+
+// Front returns the front item from the queue without removing it
+func (q *LockFreeQueue[T]) Front() (T, error) {
+	next := q.head.Load().next.Load()
+	if next == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return next.value, nil
+}
+
+// Len returns the number of items in the queue; while other goroutines are
+// enqueuing or dequeuing it is only a snapshot
+func (q *LockFreeQueue[T]) Len() int {
+	return max(int(q.size.Load()), 0)
+}
+
+// IsEmpty reports whether the queue has no items
+func (q *LockFreeQueue[T]) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
@@ -0,0 +1,210 @@
+package stackqueue
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLockFreeStackOrder(t *testing.T) {
+	var s LockFreeStack[int]
+	if _, err := s.Pop(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("Pop on empty stack: err = %v, want ErrEmpty", err)
+	}
+	for i := range 5 {
+		s.Push(i)
+	}
+	if top, err := s.Peek(); err != nil || top != 4 || s.Len() != 5 {
+		t.Fatalf("Peek() = %d, %v with Len() %d; want 4 with Len() 5", top, err, s.Len())
+	}
+	for want := 4; want >= 0; want-- {
+		if got, err := s.Pop(); err != nil || got != want {
+			t.Fatalf("Pop() = %d, %v; want %d", got, err, want)
+		}
+	}
+	if !s.IsEmpty() {
+		t.Fatal("stack not empty after popping every item")
+	}
+}
+
+func TestLockFreeQueueOrder(t *testing.T) {
+	q := NewLockFreeQueue[int]()
+	if _, err := q.Dequeue(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("Dequeue on empty queue: err = %v, want ErrEmpty", err)
+	}
+	for i := range 5 {
+		q.Enqueue(i)
+	}
+	if front, err := q.Front(); err != nil || front != 0 || q.Len() != 5 {
+		t.Fatalf("Front() = %d, %v with Len() %d; want 0 with Len() 5", front, err, q.Len())
+	}
+	for want := range 5 {
+		if got, err := q.Dequeue(); err != nil || got != want {
+			t.Fatalf("Dequeue() = %d, %v; want %d", got, err, want)
+		}
+	}
+	if !q.IsEmpty() {
+		t.Fatal("queue not empty after dequeuing every item")
+	}
+}
+
+const (
+	stressProducers = 8
+	stressConsumers = 8
+	stressItems     = 20000 // per producer
+)
+
+// stress runs producers that each put stressItems distinct values, encoded
+// as producer*stressItems+i, and consumers that take values until all have
+// been taken. It returns what each consumer took, in order.
+func stress(put func(int), take func() (int, error)) [][]int {
+	var wg sync.WaitGroup
+	var remaining atomic.Int64
+	remaining.Store(stressProducers * stressItems)
+	for p := range stressProducers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range stressItems {
+				put(p*stressItems + i)
+			}
+		}()
+	}
+	taken := make([][]int, stressConsumers)
+	for c := range stressConsumers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for remaining.Load() > 0 {
+				if v, err := take(); err == nil {
+					remaining.Add(-1)
+					taken[c] = append(taken[c], v)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return taken
+}
+
+// checkExactlyOnce fails unless every produced value was taken exactly once
+func checkExactlyOnce(t *testing.T, taken [][]int) {
+	t.Helper()
+	seen := make([]bool, stressProducers*stressItems)
+	for _, values := range taken {
+		for _, v := range values {
+			if v < 0 || v >= len(seen) {
+				t.Fatalf("took value %d that was never produced", v)
+			}
+			if seen[v] {
+				t.Fatalf("took value %d twice", v)
+			}
+			seen[v] = true
+		}
+	}
+	for v, ok := range seen {
+		if !ok {
+			t.Fatalf("value %d was lost", v)
+		}
+	}
+}
+
+func TestLockFreeStackConcurrent(t *testing.T) {
+	var s LockFreeStack[int]
+	checkExactlyOnce(t, stress(s.Push, s.Pop))
+	if !s.IsEmpty() || s.Len() != 0 {
+		t.Fatalf("stack holds %d items after the stress run", s.Len())
+	}
+}
+
+func TestLockFreeQueueConcurrent(t *testing.T) {
+	q := NewLockFreeQueue[int]()
+	taken := stress(q.Enqueue, q.Dequeue)
+	checkExactlyOnce(t, taken)
+	// A consumer must see the values of any one producer in the order they
+	// were enqueued
+	for c, values := range taken {
+		last := make([]int, stressProducers)
+		for p := range last {
+			last[p] = -1
+		}
+		for _, v := range values {
+			p := v / stressItems
+			if v <= last[p] {
+				t.Fatalf("consumer %d took %d after %d from the same producer", c, v, last[p])
+			}
+			last[p] = v
+		}
+	}
+	if !q.IsEmpty() || q.Len() != 0 {
+		t.Fatalf("queue holds %d items after the stress run", q.Len())
+	}
+}
+
+// mutexStack and mutexQueue guard the plain types with a mutex, the
+// alternative the lock-free types are measured against
+type mutexStack struct {
+	mu sync.Mutex
+	s  Stack[int]
+}
+
+func (m *mutexStack) Push(item int) {
+	m.mu.Lock()
+	m.s.Push(item)
+	m.mu.Unlock()
+}
+
+func (m *mutexStack) Pop() (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.s.Pop()
+}
+
+type mutexQueue struct {
+	mu sync.Mutex
+	q  Queue[int]
+}
+
+func (m *mutexQueue) Enqueue(item int) {
+	m.mu.Lock()
+	m.q.Enqueue(item)
+	m.mu.Unlock()
+}
+
+func (m *mutexQueue) Dequeue() (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.q.Dequeue()
+}
+
+// benchmarkPutTake has every goroutine alternate between putting and taking
+// an item, so all of them contend on the same ends of the structure
+func benchmarkPutTake(b *testing.B, put func(int), take func() (int, error)) {
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			put(i)
+			take()
+		}
+	})
+}
+
+func BenchmarkTreiberStack(b *testing.B) {
+	var s LockFreeStack[int]
+	benchmarkPutTake(b, s.Push, s.Pop)
+}
+
+func BenchmarkMutexStack(b *testing.B) {
+	var s mutexStack
+	benchmarkPutTake(b, s.Push, s.Pop)
+}
+
+func BenchmarkMSQueue(b *testing.B) {
+	q := NewLockFreeQueue[int]()
+	benchmarkPutTake(b, q.Enqueue, q.Dequeue)
+}
+
+func BenchmarkMutexQueue(b *testing.B) {
+	var q mutexQueue
+	benchmarkPutTake(b, q.Enqueue, q.Dequeue)
+}
@@ -0,0 +1,187 @@
+package monotonic
+
+import (
+	"cmp"
+
+	stackqueue "github.com/kuldeep-bishnoi/Golang-DSA/10_Stacks_Queues"
+)
+
+// Number is the set of types the area and volume functions can multiply and add
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// nearest scans arr in the given direction with a stack of indices whose
+// values stay monotonic; for every position it records the index of the
+// closest element on the far side of the scan that beats it, or -1
+func nearest[T cmp.Ordered](arr []T, fromRight bool, beats func(a, b T) bool) []int {
+	result := make([]int, len(arr))
+	stack := &stackqueue.Stack[int]{}
+	for k := range arr {
+		i := k
+		if fromRight {
+			i = len(arr) - 1 - k
+		}
+		// Pop everything the current element does not beat from the far side
+		for !stack.IsEmpty() {
+			top, _ := stack.Peek()
+			if beats(arr[top], arr[i]) {
+				break
+			}
+			stack.Pop()
+		}
+		result[i] = -1
+		if top, err := stack.Peek(); err == nil {
+			result[i] = top
+		}
+		stack.Push(i)
+	}
+	return result
+}
+
+// NextGreater returns, for every index, the index of the first strictly
+// greater element to its right, or -1 if there is none
+func NextGreater[T cmp.Ordered](arr []T) []int {
+	return nearest(arr, true, func(a, b T) bool { return a > b })
+}
+
+// NextSmaller returns, for every index, the index of the first strictly
+// smaller element to its right, or -1 if there is none
+func NextSmaller[T cmp.Ordered](arr []T) []int {
+	return nearest(arr, true, func(a, b T) bool { return a < b })
+}
+
+// PrevGreater returns, for every index, the index of the closest strictly
+// greater element to its left, or -1 if there is none
+func PrevGreater[T cmp.Ordered](arr []T) []int {
+	return nearest(arr, false, func(a, b T) bool { return a > b })
+}
+
+// PrevSmaller returns, for every index, the index of the closest strictly
+// smaller element to its left, or -1 if there is none
+func PrevSmaller[T cmp.Ordered](arr []T) []int {
+	return nearest(arr, false, func(a, b T) bool { return a < b })
+}
+
+// StockSpan returns, for every day, the number of consecutive days ending on
+// that day whose price was less than or equal to that day's price
+func StockSpan[T cmp.Ordered](prices []T) []int {
+	prev := PrevGreater(prices)
+	spans := make([]int, len(prices))
+	for i := range prices {
+		spans[i] = i - prev[i]
+	}
+	return spans
+}
+
+// LargestRectangle returns the area of the largest rectangle that fits under
+// a histogram with the given bar heights. Each bar is popped from an
+// increasing stack when a lower bar ends its rectangle, which bounds its width.
+func LargestRectangle[T Number](heights []T) T {
+	var best T
+	stack := &stackqueue.Stack[int]{}
+	for i := 0; i <= len(heights); i++ {
+		for !stack.IsEmpty() {
+			top, _ := stack.Peek()
+			if i < len(heights) && heights[top] <= heights[i] {
+				break
+			}
+			stack.Pop()
+			left := -1
+			if below, err := stack.Peek(); err == nil {
+				left = below
+			}
+			if area := heights[top] * T(i-left-1); area > best {
+				best = area
+			}
+		}
+		stack.Push(i)
+	}
+	return best
+}
+
+// MaximalRectangle returns the area of the largest all-true rectangle in a
+// binary matrix by treating every row as the base of a histogram
+func MaximalRectangle(matrix [][]bool) int {
+	if len(matrix) == 0 {
+		return 0
+	}
+	heights := make([]int, len(matrix[0]))
+	best := 0
+	for _, row := range matrix {
+		for j, filled := range row {
+			if filled {
+				heights[j]++
+			} else {
+				heights[j] = 0
+			}
+		}
+		best = max(best, LargestRectangle(heights))
+	}
+	return best
+}
+
+// TrapRainWater returns how much water collects between bars of the given
+// heights. A decreasing stack holds the left walls of open basins; each
+// taller bar closes a layer of water over the bars it pops.
+func TrapRainWater[T Number](heights []T) T {
+	var water T
+	stack := &stackqueue.Stack[int]{}
+	for i, h := range heights {
+		for !stack.IsEmpty() {
+			bottom, _ := stack.Peek()
+			if heights[bottom] >= h {
+				break
+			}
+			stack.Pop()
+			left, err := stack.Peek()
+			if err != nil {
+				break
+			}
+			depth := min(heights[left], h) - heights[bottom]
+			water += depth * T(i-left-1)
+		}
+		stack.Push(i)
+	}
+	return water
+}
+
+// window slides a window of size k over arr keeping a deque of indices whose
+// values are monotonic, so the front always holds the window's best element
+func window[T cmp.Ordered](arr []T, k int, better func(a, b T) bool) []T {
+	if k <= 0 || k > len(arr) {
+		return nil
+	}
+	result := make([]T, 0, len(arr)-k+1)
+	deque := &stackqueue.Deque[int]{}
+	for i, v := range arr {
+		if front, err := deque.Front(); err == nil && front <= i-k {
+			deque.PopFront()
+		}
+		for !deque.IsEmpty() {
+			back, _ := deque.Back()
+			if better(arr[back], v) {
+				break
+			}
+			deque.PopBack()
+		}
+		deque.PushBack(i)
+		if i >= k-1 {
+			front, _ := deque.Front()
+			result = append(result, arr[front])
+		}
+	}
+	return result
+}
+
+// SlidingWindowMax returns the maximum of every window of k consecutive elements
+func SlidingWindowMax[T cmp.Ordered](arr []T, k int) []T {
+	return window(arr, k, func(a, b T) bool { return a > b })
+}
+
+// SlidingWindowMin returns the minimum of every window of k consecutive elements
+func SlidingWindowMin[T cmp.Ordered](arr []T, k int) []T {
+	return window(arr, k, func(a, b T) bool { return a < b })
+}
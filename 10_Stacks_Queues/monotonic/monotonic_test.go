@@ -0,0 +1,208 @@
+package monotonic
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// bruteNearest finds, for every index, the closest index in the scan
+// direction whose value beats it, by checking every candidate
+func bruteNearest(arr []int, fromRight bool, beats func(a, b int) bool) []int {
+	result := make([]int, len(arr))
+	for i := range arr {
+		result[i] = -1
+		for d := 1; d < len(arr); d++ {
+			j := i - d
+			if fromRight {
+				j = i + d
+			}
+			if j < 0 || j >= len(arr) {
+				break
+			}
+			if beats(arr[j], arr[i]) {
+				result[i] = j
+				break
+			}
+		}
+	}
+	return result
+}
+
+func greater(a, b int) bool { return a > b }
+func less(a, b int) bool    { return a < b }
+
+func bruteStockSpan(prices []int) []int {
+	spans := make([]int, len(prices))
+	for i := range prices {
+		for j := i; j >= 0 && prices[j] <= prices[i]; j-- {
+			spans[i]++
+		}
+	}
+	return spans
+}
+
+func bruteLargestRectangle(heights []int) int {
+	best := 0
+	for i := range heights {
+		lowest := heights[i]
+		for j := i; j < len(heights); j++ {
+			lowest = min(lowest, heights[j])
+			best = max(best, lowest*(j-i+1))
+		}
+	}
+	return best
+}
+
+func bruteTrap(heights []int) int {
+	water := 0
+	for i, h := range heights {
+		left := slices.Max(heights[:i+1])
+		right := slices.Max(heights[i:])
+		water += min(left, right) - h
+	}
+	return water
+}
+
+func bruteWindow(arr []int, k int, best func([]int) int) []int {
+	if k <= 0 || k > len(arr) {
+		return nil
+	}
+	var result []int
+	for i := 0; i+k <= len(arr); i++ {
+		result = append(result, best(arr[i:i+k]))
+	}
+	return result
+}
+
+func bruteMaximalRectangle(matrix [][]bool) int {
+	best := 0
+	for top := range matrix {
+		for left := range matrix[top] {
+			for bottom := top; bottom < len(matrix); bottom++ {
+				for right := left; right < len(matrix[top]); right++ {
+					full := true
+					for r := top; r <= bottom && full; r++ {
+						for c := left; c <= right && full; c++ {
+							full = matrix[r][c]
+						}
+					}
+					if full {
+						best = max(best, (bottom-top+1)*(right-left+1))
+					}
+				}
+			}
+		}
+	}
+	return best
+}
+
+func randomHeights(rng *rand.Rand) []int {
+	arr := make([]int, rng.Intn(30))
+	// a small range so equal values are common
+	for i := range arr {
+		arr[i] = rng.Intn(6)
+	}
+	return arr
+}
+
+func TestAgainstBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 2000 {
+		arr := randomHeights(rng)
+		nearest := []struct {
+			name string
+			got  []int
+			want []int
+		}{
+			{"NextGreater", NextGreater(arr), bruteNearest(arr, true, greater)},
+			{"NextSmaller", NextSmaller(arr), bruteNearest(arr, true, less)},
+			{"PrevGreater", PrevGreater(arr), bruteNearest(arr, false, greater)},
+			{"PrevSmaller", PrevSmaller(arr), bruteNearest(arr, false, less)},
+			{"StockSpan", StockSpan(arr), bruteStockSpan(arr)},
+		}
+		for _, n := range nearest {
+			if !slices.Equal(n.got, n.want) {
+				t.Fatalf("%s(%v) = %v, want %v", n.name, arr, n.got, n.want)
+			}
+		}
+		if got, want := LargestRectangle(arr), bruteLargestRectangle(arr); got != want {
+			t.Fatalf("LargestRectangle(%v) = %d, want %d", arr, got, want)
+		}
+		if got, want := TrapRainWater(arr), bruteTrap(arr); got != want {
+			t.Fatalf("TrapRainWater(%v) = %d, want %d", arr, got, want)
+		}
+		k := rng.Intn(len(arr)+2) - 1
+		if got, want := SlidingWindowMax(arr, k), bruteWindow(arr, k, slices.Max[[]int]); !slices.Equal(got, want) {
+			t.Fatalf("SlidingWindowMax(%v, %d) = %v, want %v", arr, k, got, want)
+		}
+		if got, want := SlidingWindowMin(arr, k), bruteWindow(arr, k, slices.Min[[]int]); !slices.Equal(got, want) {
+			t.Fatalf("SlidingWindowMin(%v, %d) = %v, want %v", arr, k, got, want)
+		}
+	}
+}
+
+func TestMaximalRectangleAgainstBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 500 {
+		rows, cols := rng.Intn(6), rng.Intn(6)
+		matrix := make([][]bool, rows)
+		for r := range matrix {
+			matrix[r] = make([]bool, cols)
+			for c := range matrix[r] {
+				matrix[r][c] = rng.Intn(4) > 0
+			}
+		}
+		if got, want := MaximalRectangle(matrix), bruteMaximalRectangle(matrix); got != want {
+			t.Fatalf("MaximalRectangle(%v) = %d, want %d", matrix, got, want)
+		}
+	}
+}
+
+func TestExamples(t *testing.T) {
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"NextGreater", NextGreater([]int{2, 1, 2, 4, 3}), []int{3, 2, 3, -1, -1}},
+		{"NextGreater of nothing", NextGreater([]int{}), []int{}},
+		{"PrevSmaller with ties", PrevSmaller([]int{3, 3, 1, 3}), []int{-1, -1, -1, 2}},
+		{"StockSpan", StockSpan([]int{100, 80, 60, 70, 60, 75, 85}), []int{1, 1, 1, 2, 1, 4, 6}},
+		{"LargestRectangle", LargestRectangle([]int{2, 1, 5, 6, 2, 3}), 10},
+		{"LargestRectangle of floats", LargestRectangle([]float64{1.5, 2, 2}), 4.5},
+		{"LargestRectangle of nothing", LargestRectangle([]int{}), 0},
+		{"TrapRainWater", TrapRainWater([]int{0, 1, 0, 2, 1, 0, 1, 3, 2, 1, 2, 1}), 6},
+		{"TrapRainWater of a slope", TrapRainWater([]int{1, 2, 3}), 0},
+		{"SlidingWindowMax", SlidingWindowMax([]int{1, 3, -1, -3, 5, 3, 6, 7}, 3), []int{3, 3, 5, 5, 6, 7}},
+		{"SlidingWindowMin", SlidingWindowMin([]int{1, 3, -1, -3, 5, 3, 6, 7}, 3), []int{-1, -3, -3, -3, 3, 3}},
+		{"SlidingWindowMax of strings", SlidingWindowMax([]string{"b", "a", "c"}, 2), []string{"b", "c"}},
+		{"window wider than the input", SlidingWindowMax([]int{1, 2}, 3), []int(nil)},
+		{"empty window", SlidingWindowMin([]int{1, 2}, 0), []int(nil)},
+		{"MaximalRectangle", MaximalRectangle([][]bool{
+			{true, false, true, false, false},
+			{true, false, true, true, true},
+			{true, true, true, true, true},
+			{true, false, false, true, false},
+		}), 6},
+		{"MaximalRectangle of nothing", MaximalRectangle(nil), 0},
+	}
+	for _, tt := range tests {
+		if !equal(tt.got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+// equal compares the results of TestExamples, which mix slices and scalars
+func equal(a, b any) bool {
+	switch a := a.(type) {
+	case []int:
+		b, ok := b.([]int)
+		return ok && slices.Equal(a, b) && (a == nil) == (b == nil)
+	case []string:
+		b, ok := b.([]string)
+		return ok && slices.Equal(a, b)
+	}
+	return a == b
+}
@@ -1,85 +1,121 @@
-package main
+package stackqueue
 
-import "fmt"
+import (
+	"errors"
+	"iter"
+	"slices"
+)
+
+// ErrEmpty is returned when reading from an empty stack or queue
+var ErrEmpty = errors.New("stackqueue: empty")
 
 // Stack struct using a slice
-type Stack struct {
-	items []int
+type Stack[T any] struct {
+	items []T
 }
 
 // Push adds an item to the stack
-func (s *Stack) Push(item int) {
+func (s *Stack[T]) Push(item T) {
 	s.items = append(s.items, item)
 }
 
 // Pop removes and returns the top item from the stack
-func (s *Stack) Pop() int {
+func (s *Stack[T]) Pop() (T, error) {
+	var zero T
 	if len(s.items) == 0 {
-		fmt.Println("Stack is empty")
-		return -1
+		return zero, ErrEmpty
 	}
 	item := s.items[len(s.items)-1]
+	s.items[len(s.items)-1] = zero
 	s.items = s.items[:len(s.items)-1]
-	return item
+	return item, nil
 }
 
 // Peek returns the top item from the stack without removing it
-func (s *Stack) Peek() int {
+func (s *Stack[T]) Peek() (T, error) {
 	if len(s.items) == 0 {
-		fmt.Println("Stack is empty")
-		return -1
+		var zero T
+		return zero, ErrEmpty
+	}
+	return s.items[len(s.items)-1], nil
+}
+
+// Len returns the number of items in the stack
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// IsEmpty reports whether the stack has no items
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Clear removes all items from the stack
+func (s *Stack[T]) Clear() {
+	clear(s.items)
+	s.items = s.items[:0]
+}
+
+// Values returns the items from the top of the stack to the bottom
+func (s *Stack[T]) Values() []T {
+	values := slices.Clone(s.items)
+	slices.Reverse(values)
+	return values
+}
+
+// All returns an iterator over the items from the top of the stack to the bottom
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s.items) - 1; i >= 0; i-- {
+			if !yield(s.items[i]) {
+				return
+			}
+		}
 	}
-	return s.items[len(s.items)-1]
 }
 
-// Queue struct using a slice
-type Queue struct {
-	items []int
+// Queue struct using a ring buffer, so dequeued slots are reused instead of
+// being left behind at the front of an ever-advancing slice
+type Queue[T any] struct {
+	items Deque[T]
 }
 
 // Enqueue adds an item to the queue
-func (q *Queue) Enqueue(item int) {
-	q.items = append(q.items, item)
+func (q *Queue[T]) Enqueue(item T) {
+	q.items.PushBack(item)
 }
 
 // Dequeue removes and returns the front item from the queue
-func (q *Queue) Dequeue() int {
-	if len(q.items) == 0 {
-		fmt.Println("Queue is empty")
-		return -1
-	}
-	item := q.items[0]
-	q.items = q.items[1:]
-	return item
+func (q *Queue[T]) Dequeue() (T, error) {
+	return q.items.PopFront()
 }
 
 // Front returns the front item from the queue without removing it
-func (q *Queue) Front() int {
-	if len(q.items) == 0 {
-		fmt.Println("Queue is empty")
-		return -1
-	}
-	return q.items[0]
-}
-
-func main() {
-	// Stack operations
-	stack := &Stack{}
-	stack.Push(1)
-	stack.Push(2)
-	stack.Push(3)
-	fmt.Println("Stack Peek:", stack.Peek())
-	fmt.Println("Stack Pop:", stack.Pop())
-	fmt.Println("Stack Pop:", stack.Pop())
-	fmt.Println("Stack Pop:", stack.Pop())
-
-	// Queue operations
-	queue := &Queue{}
-	queue.Enqueue(1)
-	queue.Enqueue(2)
-	queue.Enqueue(3)
-	fmt.Println("Queue Front:", queue.Front())
-	fmt.Println("Queue Dequeue:", queue.Dequeue())
-	fmt.Println("Queue Dequeue:", queue.Dequeue())
-	fmt.Println("Queue Dequeue:", queue.Dequeue())
+func (q *Queue[T]) Front() (T, error) {
+	return q.items.Front()
+}
+
+// Len returns the number of items in the queue
+func (q *Queue[T]) Len() int {
+	return q.items.Len()
+}
+
+// IsEmpty reports whether the queue has no items
+func (q *Queue[T]) IsEmpty() bool {
+	return q.items.IsEmpty()
+}
+
+// Clear removes all items from the queue
+func (q *Queue[T]) Clear() {
+	q.items.Clear()
+}
+
+// Values returns the items from the front of the queue to the back
+func (q *Queue[T]) Values() []T {
+	return q.items.Values()
+}
+
+// All returns an iterator over the items from the front of the queue to the back
+func (q *Queue[T]) All() iter.Seq[T] {
+	return q.items.All()
 }
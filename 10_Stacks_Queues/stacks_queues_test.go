@@ -0,0 +1,159 @@
+package stackqueue
+
+import (
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+)
+
+func TestStackEmpty(t *testing.T) {
+	var s Stack[int]
+	if _, err := s.Pop(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Pop on empty stack: err = %v, want ErrEmpty", err)
+	}
+	if _, err := s.Peek(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Peek on empty stack: err = %v, want ErrEmpty", err)
+	}
+	// popping the last item leaves a stack that is empty again
+	s.Push(1)
+	s.Pop()
+	if !s.IsEmpty() || s.Len() != 0 {
+		t.Errorf("stack holds %d items after popping the only one", s.Len())
+	}
+	if _, err := s.Pop(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Pop after emptying: err = %v, want ErrEmpty", err)
+	}
+}
+
+func TestStackLIFO(t *testing.T) {
+	var s Stack[string]
+	for _, item := range []string{"a", "b", "c"} {
+		s.Push(item)
+	}
+	if got := s.Values(); !slices.Equal(got, []string{"c", "b", "a"}) {
+		t.Fatalf("Values() = %q, want top to bottom [c b a]", got)
+	}
+	if top, err := s.Peek(); err != nil || top != "c" || s.Len() != 3 {
+		t.Fatalf("Peek() = %q, %v with %d items; want c with 3 items", top, err, s.Len())
+	}
+	for _, want := range []string{"c", "b", "a"} {
+		if got, err := s.Pop(); err != nil || got != want {
+			t.Fatalf("Pop() = %q, %v; want %q", got, err, want)
+		}
+	}
+}
+
+func TestStackValuesIsACopy(t *testing.T) {
+	var s Stack[int]
+	s.Push(1)
+	s.Push(2)
+	values := s.Values()
+	values[0] = 99
+	if top, _ := s.Peek(); top != 2 {
+		t.Fatalf("changing the Values() slice changed the top to %d", top)
+	}
+}
+
+// TestStackReleasesItems checks that Pop and Clear zero the slots they
+// vacate, so the backing array does not keep popped items alive
+func TestStackReleasesItems(t *testing.T) {
+	var s Stack[*int]
+	for i := range 4 {
+		s.Push(&i)
+	}
+	s.Pop()
+	s.Pop()
+	if live := slices.IndexFunc(s.items[:cap(s.items)][2:], func(p *int) bool { return p != nil }); live >= 0 {
+		t.Fatalf("popped slot %d still holds a pointer", 2+live)
+	}
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Fatalf("stack holds %d items after Clear", s.Len())
+	}
+	if slices.ContainsFunc(s.items[:cap(s.items)], func(p *int) bool { return p != nil }) {
+		t.Fatal("Clear left pointers in the backing array")
+	}
+	// the stack keeps working after Clear
+	v := 7
+	s.Push(&v)
+	if top, err := s.Peek(); err != nil || *top != 7 {
+		t.Fatal("Push after Clear did not become the top")
+	}
+}
+
+func TestQueueClearAndValues(t *testing.T) {
+	var q Queue[int]
+	for i := range 5 {
+		q.Enqueue(i)
+	}
+	q.Dequeue()
+	if got := q.Values(); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Fatalf("Values() = %v, want front to back [1 2 3 4]", got)
+	}
+	q.Clear()
+	if !q.IsEmpty() || q.Len() != 0 {
+		t.Fatalf("queue holds %d items after Clear", q.Len())
+	}
+	if _, err := q.Front(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("Front after Clear: err = %v, want ErrEmpty", err)
+	}
+	q.Enqueue(9)
+	if got, err := q.Dequeue(); err != nil || got != 9 {
+		t.Fatalf("Dequeue after Clear = %d, %v; want 9", got, err)
+	}
+}
+
+func TestIterators(t *testing.T) {
+	for _, values := range [][]int{nil, {1}, {1, 2, 3}} {
+		var s Stack[int]
+		var q Queue[int]
+		for _, v := range values {
+			s.Push(v)
+			q.Enqueue(v)
+		}
+		top := slices.Clone(values)
+		slices.Reverse(top)
+		if got := slices.Collect(s.All()); !slices.Equal(got, top) {
+			t.Errorf("Stack.All() after pushing %v = %v, want %v", values, got, top)
+		}
+		if got := slices.Collect(q.All()); !slices.Equal(got, values) {
+			t.Errorf("Queue.All() after enqueuing %v = %v, want %v", values, got, values)
+		}
+	}
+}
+
+// TestIteratorsStopEarly breaks out of each iterator; the range loop panics
+// if an iterator keeps calling yield after the body has stopped it
+func TestIteratorsStopEarly(t *testing.T) {
+	var s Stack[int]
+	var q Queue[int]
+	for v := range 5 {
+		s.Push(v)
+		q.Enqueue(v)
+	}
+	tests := []struct {
+		name string
+		seq  iter.Seq[int]
+		want []int
+	}{
+		{"Stack.All", s.All(), []int{4, 3}},
+		{"Queue.All", q.All(), []int{0, 1}},
+	}
+	for _, tt := range tests {
+		var got []int
+		for v := range tt.seq {
+			got = append(got, v)
+			if len(got) == 2 {
+				break
+			}
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s stopped after 2 values yielded %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	// stopping early leaves the items in place
+	if s.Len() != 5 || q.Len() != 5 {
+		t.Errorf("Len() after a stopped iteration = %d, %d; want 5, 5", s.Len(), q.Len())
+	}
+}
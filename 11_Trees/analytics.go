@@ -0,0 +1,200 @@
+package tree
+
+import "math"
+
+// Height returns the number of nodes on the longest root-to-leaf path, 0 for an empty tree
+func (bt *BinaryTree) Height() int {
+	return nodeHeight(bt.Root)
+}
+
+func nodeHeight(node *Node) int {
+	if node == nil {
+		return 0
+	}
+	return 1 + max(nodeHeight(node.Left), nodeHeight(node.Right))
+}
+
+// Size returns the number of nodes in the tree
+func (bt *BinaryTree) Size() int {
+	size := 0
+	for range bt.PreOrder() {
+		size++
+	}
+	return size
+}
+
+// Diameter returns the number of edges on the longest path between any two
+// nodes. The longest path through a node joins the heights of its two
+// subtrees, so one post-order pass computing heights finds it.
+func (bt *BinaryTree) Diameter() int {
+	diameter := 0
+	var height func(node *Node) int
+	height = func(node *Node) int {
+		if node == nil {
+			return 0
+		}
+		left, right := height(node.Left), height(node.Right)
+		diameter = max(diameter, left+right)
+		return 1 + max(left, right)
+	}
+	height(bt.Root)
+	return diameter
+}
+
+// IsBalanced reports whether the heights of the two subtrees of every node
+// differ by at most one
+func (bt *BinaryTree) IsBalanced() bool {
+	// balancedHeight returns -1 as soon as any subtree is unbalanced
+	var balancedHeight func(node *Node) int
+	balancedHeight = func(node *Node) int {
+		if node == nil {
+			return 0
+		}
+		left := balancedHeight(node.Left)
+		if left < 0 {
+			return -1
+		}
+		right := balancedHeight(node.Right)
+		if right < 0 || left-right > 1 || right-left > 1 {
+			return -1
+		}
+		return 1 + max(left, right)
+	}
+	return balancedHeight(bt.Root) >= 0
+}
+
+// IsBST reports whether the tree is a valid binary search tree in the sense
+// of Insert: every value in a left subtree is smaller than its ancestor and
+// every value in a right subtree is greater or equal. Each node is checked
+// against the bounds inherited from all its ancestors, not just its parent.
+func (bt *BinaryTree) IsBST() bool {
+	var within func(node *Node, lo, hi *int) bool
+	within = func(node *Node, lo, hi *int) bool {
+		if node == nil {
+			return true
+		}
+		if (lo != nil && node.Value < *lo) || (hi != nil && node.Value >= *hi) {
+			return false
+		}
+		return within(node.Left, lo, &node.Value) && within(node.Right, &node.Value, hi)
+	}
+	return within(bt.Root, nil, nil)
+}
+
+// IsSymmetric reports whether the tree is a mirror image of itself
+func (bt *BinaryTree) IsSymmetric() bool {
+	var mirror func(a, b *Node) bool
+	mirror = func(a, b *Node) bool {
+		if a == nil || b == nil {
+			return a == b
+		}
+		return a.Value == b.Value && mirror(a.Left, b.Right) && mirror(a.Right, b.Left)
+	}
+	return bt.Root == nil || mirror(bt.Root.Left, bt.Root.Right)
+}
+
+// LowestCommonAncestor returns the deepest node that has nodes with both
+// values in its subtree, or nil if either value is missing. A node counts as
+// its own descendant. Without an ordering to follow, both subtrees of every
+// node are searched. If repeated values make several nodes qualify, the
+// first one in post-order is returned.
+func (bt *BinaryTree) LowestCommonAncestor(p, q int) *Node {
+	var lca *Node
+	// search reports whether the subtree holds p and whether it holds q,
+	// recording the first node found to hold both
+	var search func(node *Node) (bool, bool)
+	search = func(node *Node) (bool, bool) {
+		if node == nil || lca != nil {
+			return false, false
+		}
+		leftP, leftQ := search(node.Left)
+		rightP, rightQ := search(node.Right)
+		foundP := leftP || rightP || node.Value == p
+		foundQ := leftQ || rightQ || node.Value == q
+		if foundP && foundQ && lca == nil {
+			lca = node
+		}
+		return foundP, foundQ
+	}
+	search(bt.Root)
+	return lca
+}
+
+// LowestCommonAncestor returns the deepest node that has nodes with both
+// values in its subtree, or nil if either value is missing. The search
+// follows the ordering down from the root to where p and q split.
+func (bst *BinarySearchTree) LowestCommonAncestor(p, q int) *Node {
+	if !bst.Search(p) || !bst.Search(q) {
+		return nil
+	}
+	node := bst.Root
+	for node != nil {
+		switch {
+		case p < node.Value && q < node.Value:
+			node = node.Left
+		case p > node.Value && q > node.Value:
+			node = node.Right
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+// MaxPathSum returns the largest sum of values along any path between two
+// nodes, where a path may start and end anywhere but visits each node at
+// most once
+func (bt *BinaryTree) MaxPathSum() (int, error) {
+	if bt.Root == nil {
+		return 0, ErrEmpty
+	}
+	best := math.MinInt
+	// gain returns the best sum of a downward path starting at node
+	var gain func(node *Node) int
+	gain = func(node *Node) int {
+		if node == nil {
+			return 0
+		}
+		left, right := max(gain(node.Left), 0), max(gain(node.Right), 0)
+		best = max(best, node.Value+left+right)
+		return node.Value + max(left, right)
+	}
+	gain(bt.Root)
+	return best, nil
+}
+
+// PathsWithSum returns every root-to-leaf path whose values add up to target,
+// in left-to-right order
+func (bt *BinaryTree) PathsWithSum(target int) [][]int {
+	var paths [][]int
+	var path []int
+	var walk func(node *Node, remaining int)
+	walk = func(node *Node, remaining int) {
+		if node == nil {
+			return
+		}
+		path = append(path, node.Value)
+		remaining -= node.Value
+		if node.Left == nil && node.Right == nil && remaining == 0 {
+			paths = append(paths, append([]int(nil), path...))
+		}
+		walk(node.Left, remaining)
+		walk(node.Right, remaining)
+		path = path[:len(path)-1]
+	}
+	walk(bt.Root, target)
+	return paths
+}
+
+// KthSmallest returns the k-th smallest value, counting from 1, stopping the
+// in-order walk as soon as it is reached
+func (bst *BinarySearchTree) KthSmallest(k int) (int, error) {
+	if k >= 1 {
+		for value := range bst.All() {
+			if k--; k == 0 {
+				return value, nil
+			}
+		}
+	}
+	return 0, ErrOutOfRange
+}
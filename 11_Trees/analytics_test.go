@@ -0,0 +1,325 @@
+package tree
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestAnalytics(t *testing.T) {
+	tests := []struct {
+		tree                     string
+		height, size, diameter   int
+		balanced, bst, symmetric bool
+	}{
+		{"", 0, 0, 0, true, true, true},
+		{"1", 1, 1, 0, true, true, true},
+		{"2(1)(3)", 2, 3, 2, true, true, false},
+		{"1(2(3))(2()(3))", 3, 5, 4, true, false, true},
+		{"1(2(3(4)))", 4, 4, 3, false, false, false},
+		{"1(2(4(8))(5()(9)))(3)", 4, 7, 4, false, false, false},
+		// 6 is in 10's left subtree but not below 5
+		{"5(1)(10(6)(12))", 3, 5, 3, true, true, false},
+		{"5(1)(10(4)(12))", 3, 5, 3, true, false, false},
+		// equal values go right, as Insert places them
+		{"5(3)(5)", 2, 3, 2, true, true, false},
+		{"5(5)(7)", 2, 3, 2, true, false, false},
+	}
+	for _, tt := range tests {
+		bt := mustParens(t, tt.tree)
+		if got := bt.Height(); got != tt.height {
+			t.Errorf("%q: Height() = %d, want %d", tt.tree, got, tt.height)
+		}
+		if got := bt.Size(); got != tt.size {
+			t.Errorf("%q: Size() = %d, want %d", tt.tree, got, tt.size)
+		}
+		if got := bt.Diameter(); got != tt.diameter {
+			t.Errorf("%q: Diameter() = %d, want %d", tt.tree, got, tt.diameter)
+		}
+		if got := bt.IsBalanced(); got != tt.balanced {
+			t.Errorf("%q: IsBalanced() = %v, want %v", tt.tree, got, tt.balanced)
+		}
+		if got := bt.IsBST(); got != tt.bst {
+			t.Errorf("%q: IsBST() = %v, want %v", tt.tree, got, tt.bst)
+		}
+		if got := bt.IsSymmetric(); got != tt.symmetric {
+			t.Errorf("%q: IsSymmetric() = %v, want %v", tt.tree, got, tt.symmetric)
+		}
+	}
+}
+
+func TestMaxPathSumAndPathsWithSum(t *testing.T) {
+	tests := []struct {
+		tree   string
+		maxSum int
+		target int
+		paths  [][]int
+	}{
+		{"1(2)(3)", 6, 4, [][]int{{1, 3}}},
+		{"-10(9)(20(15)(7))", 42, 17, [][]int{{-10, 20, 7}}},
+		{"-3", -3, -3, [][]int{{-3}}},
+		{"-2(-1)", -1, -3, [][]int{{-2, -1}}},
+		{"5(4(11(7)(2)))(8(13)(4(5)(1)))", 48, 22, [][]int{{5, 4, 11, 2}, {5, 8, 4, 5}}},
+		// a node with one child is not a leaf
+		{"1(2)", 3, 1, nil},
+	}
+	for _, tt := range tests {
+		bt := mustParens(t, tt.tree)
+		if got, err := bt.MaxPathSum(); err != nil || got != tt.maxSum {
+			t.Errorf("%q: MaxPathSum() = %d, %v; want %d", tt.tree, got, err, tt.maxSum)
+		}
+		got := bt.PathsWithSum(tt.target)
+		if !slices.EqualFunc(got, tt.paths, slices.Equal) {
+			t.Errorf("%q: PathsWithSum(%d) = %v, want %v", tt.tree, tt.target, got, tt.paths)
+		}
+	}
+	if _, err := (&BinaryTree{}).MaxPathSum(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("MaxPathSum on an empty tree: err = %v, want ErrEmpty", err)
+	}
+}
+
+func TestLowestCommonAncestor(t *testing.T) {
+	tests := []struct {
+		tree string
+		p, q int
+		want *int // nil when there is no ancestor
+	}{
+		{"3(5(6)(2(7)(4)))(1(0)(8))", 5, 1, ptr(3)},
+		{"3(5(6)(2(7)(4)))(1(0)(8))", 5, 4, ptr(5)},
+		{"3(5(6)(2(7)(4)))(1(0)(8))", 7, 4, ptr(2)},
+		{"3(5(6)(2(7)(4)))(1(0)(8))", 6, 6, ptr(6)},
+		{"3(5(6)(2(7)(4)))(1(0)(8))", 6, 99, nil},
+		{"3(5(6)(2(7)(4)))(1(0)(8))", 99, 98, nil},
+		{"", 1, 1, nil},
+		// two copies of p must not stand in for a missing q
+		{"0(1)(-2()(-1()(-2)))", -2, 999, nil},
+		// the deepest node holding both, not the one above an extra copy
+		{"9(1)(1(4)(1(2)))", 1, 2, ptr(1)},
+	}
+	for _, tt := range tests {
+		bt := mustParens(t, tt.tree)
+		got := bt.LowestCommonAncestor(tt.p, tt.q)
+		switch {
+		case tt.want == nil && got != nil:
+			t.Errorf("%q: LowestCommonAncestor(%d, %d) = %d, want nil", tt.tree, tt.p, tt.q, got.Value)
+		case tt.want != nil && (got == nil || got.Value != *tt.want):
+			t.Errorf("%q: LowestCommonAncestor(%d, %d) = %v, want %d", tt.tree, tt.p, tt.q, got, *tt.want)
+		}
+	}
+
+	// The repeated 1s: the answer must be the 1 whose subtree holds the 2
+	bt := mustParens(t, "9(1)(1(4)(1(2)))")
+	if got := bt.LowestCommonAncestor(1, 2); got != bt.Root.Right.Right {
+		t.Errorf("LowestCommonAncestor(1, 2) returned a node above the deepest match")
+	}
+}
+
+func ptr(v int) *int {
+	return &v
+}
+
+func TestBinarySearchTreeQueries(t *testing.T) {
+	bst := &BinarySearchTree{}
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80, 35} {
+		bst.Insert(v)
+	}
+	lcaTests := []struct {
+		p, q, want int
+	}{
+		{20, 40, 30}, {35, 20, 30}, {60, 80, 70}, {35, 80, 50}, {40, 35, 40}, {50, 50, 50},
+	}
+	for _, tt := range lcaTests {
+		if got := bst.LowestCommonAncestor(tt.p, tt.q); got == nil || got.Value != tt.want {
+			t.Errorf("LowestCommonAncestor(%d, %d) = %v, want %d", tt.p, tt.q, got, tt.want)
+		}
+	}
+	if got := bst.LowestCommonAncestor(20, 45); got != nil {
+		t.Errorf("LowestCommonAncestor with a missing value = %d, want nil", got.Value)
+	}
+
+	sorted := []int{20, 30, 35, 40, 50, 60, 70, 80}
+	for k, want := range sorted {
+		if got, err := bst.KthSmallest(k + 1); err != nil || got != want {
+			t.Errorf("KthSmallest(%d) = %d, %v; want %d", k+1, got, err, want)
+		}
+	}
+	for _, k := range []int{-1, 0, len(sorted) + 1} {
+		if _, err := bst.KthSmallest(k); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("KthSmallest(%d): err = %v, want ErrOutOfRange", k, err)
+		}
+	}
+}
+
+// pathInfo records a node's parent and depth so brute-force oracles can
+// walk between any two nodes
+type pathInfo struct {
+	node   *Node
+	parent *pathInfo
+	depth  int
+}
+
+func collectPaths(node *Node, parent *pathInfo, depth int, out []*pathInfo) []*pathInfo {
+	if node == nil {
+		return out
+	}
+	info := &pathInfo{node, parent, depth}
+	out = append(out, info)
+	out = collectPaths(node.Left, info, depth+1, out)
+	return collectPaths(node.Right, info, depth+1, out)
+}
+
+func (p *pathInfo) ancestors() []*pathInfo {
+	var chain []*pathInfo
+	for ; p != nil; p = p.parent {
+		chain = append(chain, p)
+	}
+	return chain
+}
+
+// meet returns the lowest common ancestor of a and b
+func meet(a, b *pathInfo) *pathInfo {
+	chain := a.ancestors()
+	for ; b != nil; b = b.parent {
+		if slices.Contains(chain, b) {
+			return b
+		}
+	}
+	return nil
+}
+
+// pathBetween returns the number of edges and the sum of values on the path
+// from a to b
+func pathBetween(a, b *pathInfo) (int, int) {
+	top := meet(a, b)
+	edges, sum := 0, top.node.Value
+	for _, end := range []*pathInfo{a, b} {
+		for ; end != top; end = end.parent {
+			edges++
+			sum += end.node.Value
+		}
+	}
+	return edges, sum
+}
+
+func TestAnalyticsOnGeneratedTrees(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 3000 {
+		root := randomTree(rng, rng.Intn(14), 10)
+		if root != nil && rng.Intn(4) == 0 {
+			// mirror the left subtree so symmetric trees turn up too
+			root.Right = mirror(root.Left)
+		}
+		bt := &BinaryTree{Root: root}
+		nodes := collectPaths(root, nil, 1, nil)
+
+		height, diameter, maxSum := 0, 0, math.MinInt
+		for _, a := range nodes {
+			height = max(height, a.depth)
+			for _, b := range nodes {
+				edges, sum := pathBetween(a, b)
+				diameter = max(diameter, edges)
+				maxSum = max(maxSum, sum)
+			}
+		}
+		if bt.Height() != height || bt.Size() != len(nodes) || bt.Diameter() != diameter {
+			t.Fatalf("%s: Height, Size, Diameter = %d, %d, %d; want %d, %d, %d", bt.FormatParens(),
+				bt.Height(), bt.Size(), bt.Diameter(), height, len(nodes), diameter)
+		}
+		if got, err := bt.MaxPathSum(); len(nodes) > 0 && (err != nil || got != maxSum) {
+			t.Fatalf("%s: MaxPathSum() = %d, %v; want %d", bt.FormatParens(), got, err, maxSum)
+		}
+
+		balanced, bst := true, true
+		for _, a := range nodes {
+			diff := nodeHeight(a.node.Left) - nodeHeight(a.node.Right)
+			balanced = balanced && diff >= -1 && diff <= 1
+			for _, b := range nodes {
+				chain := b.ancestors()
+				for i := 1; i < len(chain); i++ {
+					if chain[i] != a {
+						continue
+					}
+					if chain[i-1].node == a.node.Left {
+						bst = bst && b.node.Value < a.node.Value
+					} else {
+						bst = bst && b.node.Value >= a.node.Value
+					}
+				}
+			}
+		}
+		if bt.IsBalanced() != balanced || bt.IsBST() != bst || bt.IsSymmetric() != sameShape(root, mirror(root)) {
+			t.Fatalf("%s: IsBalanced, IsBST, IsSymmetric = %v, %v, %v", bt.FormatParens(),
+				bt.IsBalanced(), bt.IsBST(), bt.IsSymmetric())
+		}
+
+		target := rng.Intn(21) - 10
+		var paths [][]int
+		for _, leaf := range nodes {
+			if leaf.node.Left != nil || leaf.node.Right != nil {
+				continue
+			}
+			var path []int
+			sum := 0
+			for _, a := range slices.Backward(leaf.ancestors()) {
+				path = append(path, a.node.Value)
+				sum += a.node.Value
+			}
+			if sum == target {
+				paths = append(paths, path)
+			}
+		}
+		if got := bt.PathsWithSum(target); !slices.EqualFunc(got, paths, slices.Equal) {
+			t.Fatalf("%s: PathsWithSum(%d) = %v, want %v", bt.FormatParens(), target, got, paths)
+		}
+
+		// Values repeat in these trees: the answer must hold both values
+		// and no node below it may hold both
+		p, q := rng.Intn(21)-10, rng.Intn(21)-10
+		holds := func(node *Node) bool {
+			values := slices.Collect((&BinaryTree{Root: node}).All())
+			return slices.Contains(values, p) && slices.Contains(values, q)
+		}
+		lca := bt.LowestCommonAncestor(p, q)
+		if lca == nil {
+			if holds(root) {
+				t.Fatalf("%s: LowestCommonAncestor(%d, %d) = nil", bt.FormatParens(), p, q)
+			}
+		} else if !holds(lca) || holds(lca.Left) || holds(lca.Right) {
+			t.Fatalf("%s: LowestCommonAncestor(%d, %d) = %d is not the deepest match", bt.FormatParens(), p, q, lca.Value)
+		}
+	}
+}
+
+func TestBinarySearchTreeQueriesOnGeneratedTrees(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for range 500 {
+		bst := &BinarySearchTree{}
+		values := rng.Perm(40)[:rng.Intn(20)]
+		for _, v := range values {
+			bst.Insert(v)
+		}
+		bt := &BinaryTree{Root: bst.Root}
+		if !bt.IsBST() {
+			t.Fatal("IsBST() = false for a tree built by Insert")
+		}
+		sorted := slices.Sorted(slices.Values(values))
+		for k, want := range sorted {
+			if got, err := bst.KthSmallest(k + 1); err != nil || got != want {
+				t.Fatalf("KthSmallest(%d) = %d, %v; want %d", k+1, got, err, want)
+			}
+		}
+		p, q := rng.Intn(40), rng.Intn(40)
+		if got, want := bst.LowestCommonAncestor(p, q), bt.LowestCommonAncestor(p, q); got != want {
+			t.Fatalf("BST LowestCommonAncestor(%d, %d) differs from the general search", p, q)
+		}
+	}
+}
+
+func mirror(node *Node) *Node {
+	if node == nil {
+		return nil
+	}
+	return &Node{Value: node.Value, Left: mirror(node.Right), Right: mirror(node.Left)}
+}
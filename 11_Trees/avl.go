@@ -0,0 +1,166 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// AVLTree is an ordered map backed by an AVL tree: the heights of the two
+// subtrees of every node differ by at most one, which keeps the height below
+// 1.44 log2(n) and every update O(log n) even for sorted input.
+// The zero value is an empty map ready to use.
+type AVLTree[K cmp.Ordered, V any] struct {
+	orderedQueries[K, V]
+}
+
+func height[K cmp.Ordered, V any](n *mapNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the size and height of n from its children
+func (n *mapNode[K, V]) update() {
+	n.resize()
+	n.height = 1 + max(height(n.left), height(n.right))
+}
+
+func balanceFactor[K cmp.Ordered, V any](n *mapNode[K, V]) int {
+	return height(n.left) - height(n.right)
+}
+
+func avlRotateRight[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	n.update()
+	x.update()
+	return x
+}
+
+func avlRotateLeft[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	n.update()
+	x.update()
+	return x
+}
+
+// avlBalance restores the AVL property at n after one of its subtrees
+// changed height by one, with a single or double rotation
+func avlBalance[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	n.update()
+	switch bf := balanceFactor(n); {
+	case bf > 1:
+		if balanceFactor(n.left) < 0 {
+			n.left = avlRotateLeft(n.left)
+		}
+		return avlRotateRight(n)
+	case bf < -1:
+		if balanceFactor(n.right) > 0 {
+			n.right = avlRotateRight(n.right)
+		}
+		return avlRotateLeft(n)
+	}
+	return n
+}
+
+// Put stores value under key, replacing any previous value
+func (t *AVLTree[K, V]) Put(key K, value V) {
+	t.root = avlPut(t.root, key, value)
+}
+
+func avlPut[K cmp.Ordered, V any](n *mapNode[K, V], key K, value V) *mapNode[K, V] {
+	if n == nil {
+		return &mapNode[K, V]{key: key, value: value, size: 1, height: 1}
+	}
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = avlPut(n.left, key, value)
+	case c > 0:
+		n.right = avlPut(n.right, key, value)
+	default:
+		n.value = value
+		return n
+	}
+	return avlBalance(n)
+}
+
+// Delete removes key from the map and reports whether it was present
+func (t *AVLTree[K, V]) Delete(key K) bool {
+	if getNode(t.root, key) == nil {
+		return false
+	}
+	t.root = avlDelete(t.root, key)
+	return true
+}
+
+// avlDelete removes key from the subtree rooted at n, which must contain it,
+// replacing a node with two children by its in-order successor
+func avlDelete[K cmp.Ordered, V any](n *mapNode[K, V], key K) *mapNode[K, V] {
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = avlDelete(n.left, key)
+	case c > 0:
+		n.right = avlDelete(n.right, key)
+	default:
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		successor := minNode(n.right)
+		successor.right = avlDeleteMin(n.right)
+		successor.left = n.left
+		n = successor
+	}
+	return avlBalance(n)
+}
+
+func avlDeleteMin[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	if n.left == nil {
+		return n.right
+	}
+	n.left = avlDeleteMin(n.left)
+	return avlBalance(n)
+}
+
+// Height returns the number of nodes on the longest root-to-leaf path
+func (t *AVLTree[K, V]) Height() int {
+	return height(t.root)
+}
+
+// CheckInvariants verifies key order, subtree sizes, stored heights and the
+// AVL balance condition, returning a description of the first violation
+func (t *AVLTree[K, V]) CheckInvariants() error {
+	if err := checkOrder(t.root, nil, nil); err != nil {
+		return err
+	}
+	_, err := checkAVL(t.root)
+	return err
+}
+
+func checkAVL[K cmp.Ordered, V any](n *mapNode[K, V]) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	left, err := checkAVL(n.left)
+	if err != nil {
+		return 0, err
+	}
+	right, err := checkAVL(n.right)
+	if err != nil {
+		return 0, err
+	}
+	h := 1 + max(left, right)
+	if n.height != h {
+		return 0, fmt.Errorf("node %v records height %d, want %d", n.key, n.height, h)
+	}
+	if left-right > 1 || right-left > 1 {
+		return 0, fmt.Errorf("node %v is unbalanced: subtree heights %d and %d", n.key, left, right)
+	}
+	return h, nil
+}
@@ -0,0 +1,139 @@
+package tree
+
+import (
+	"iter"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// scanMap is the part of the ordered map API the sorted-insert benchmarks need
+type scanMap interface {
+	Put(key, value int)
+	Range(lo, hi int) iter.Seq2[int, int]
+}
+
+// candidate names a map implementation and builds an empty one
+type candidate[M any] struct {
+	name string
+	make func() M
+}
+
+const (
+	benchDegree = 32  // minimum degree of the B-trees
+	benchP      = 0.5 // promotion probability of the skip list
+)
+
+var benchMaps = []candidate[OrderedMap[int, int]]{
+	{"BST", func() OrderedMap[int, int] { return &BST[int, int]{} }},
+	{"AVL", func() OrderedMap[int, int] { return &AVLTree[int, int]{} }},
+	{"red-black", func() OrderedMap[int, int] { return &RedBlackTree[int, int]{} }},
+	{"treap", func() OrderedMap[int, int] { return &Treap[int, int]{} }},
+	{"skip-list", func() OrderedMap[int, int] { return NewSkipList[int, int](benchP) }},
+	{"splay", func() OrderedMap[int, int] { return &SplayTree[int, int]{} }},
+}
+
+func benchScanners() []candidate[scanMap] {
+	scanners := []candidate[scanMap]{
+		{"B-tree", func() scanMap { return NewBTree[int, int](benchDegree) }},
+		{"B+tree", func() scanMap { return NewBPlusTree[int, int](benchDegree) }},
+	}
+	for _, m := range benchMaps {
+		scanners = append(scanners, candidate[scanMap]{m.name, func() scanMap { return m.make() }})
+	}
+	return scanners
+}
+
+// BenchmarkSortedPut inserts keys in ascending order. Sorted keys turn the
+// plain BST into a linked list, so its cost per insert grows with n while
+// the balanced trees stay logarithmic.
+func BenchmarkSortedPut(b *testing.B) {
+	for _, n := range []int{1000, 4000} {
+		for _, m := range benchScanners() {
+			b.Run(m.name+"/n="+strconv.Itoa(n), func(b *testing.B) {
+				for range b.N {
+					ordered := m.make()
+					for key := range n {
+						ordered.Put(key, key)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkRangeScan scans every key of a map built from sorted keys
+func BenchmarkRangeScan(b *testing.B) {
+	const n = 10000
+	for _, m := range benchScanners() {
+		ordered := m.make()
+		for key := range n {
+			ordered.Put(key, key)
+		}
+		b.Run(m.name, func(b *testing.B) {
+			for range b.N {
+				sum := 0
+				for _, value := range ordered.Range(0, n) {
+					sum += value
+				}
+				if sum != n*(n-1)/2 {
+					b.Fatal("scanned the wrong values")
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkRandom runs the same operations on every OrderedMap over keys
+// inserted in random order; each op is one call
+func BenchmarkRandom(b *testing.B) {
+	const n = 1 << 16
+	keys := rand.New(rand.NewSource(1)).Perm(n)
+	build := func(m candidate[OrderedMap[int, int]]) OrderedMap[int, int] {
+		ordered := m.make()
+		for _, key := range keys {
+			ordered.Put(key, key)
+		}
+		return ordered
+	}
+	for _, m := range benchMaps {
+		b.Run(m.name+"/put", func(b *testing.B) {
+			ordered := m.make()
+			for i := range b.N {
+				if i%n == 0 {
+					ordered = m.make()
+				}
+				ordered.Put(keys[i%n], i)
+			}
+		})
+		b.Run(m.name+"/get", func(b *testing.B) {
+			ordered := build(m)
+			b.ResetTimer()
+			for i := range b.N {
+				if _, ok := ordered.Get(keys[i%n]); !ok {
+					b.Fatal("lost a key")
+				}
+			}
+		})
+		b.Run(m.name+"/rank-select", func(b *testing.B) {
+			ordered := build(m)
+			b.ResetTimer()
+			for i := range b.N {
+				if key, _ := ordered.Select(i % n); ordered.Rank(key) != i%n {
+					b.Fatal("rank and select disagree")
+				}
+			}
+		})
+		b.Run(m.name+"/delete", func(b *testing.B) {
+			var ordered OrderedMap[int, int]
+			for i := range b.N {
+				if i%n == 0 {
+					b.StopTimer()
+					ordered = build(m)
+					b.StartTimer()
+				}
+				ordered.Delete(keys[i%n])
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package tree
+
+// Node struct for binary tree
+type Node struct {
+	Value int   `json:"value"`
+	Left  *Node `json:"left,omitempty"`
+	Right *Node `json:"right,omitempty"`
+}
+
+// BinaryTree struct
+type BinaryTree struct {
+	Root *Node
+}
+
+// Insert inserts a new node into the binary tree
+func (bt *BinaryTree) Insert(value int) {
+	bt.Root = insertNode(bt.Root, value)
+}
+
+func insertNode(node *Node, value int) *Node {
+	if node == nil {
+		return &Node{Value: value}
+	}
+	if value < node.Value {
+		node.Left = insertNode(node.Left, value)
+	} else {
+		node.Right = insertNode(node.Right, value)
+	}
+	return node
+}
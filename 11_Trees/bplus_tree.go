@@ -0,0 +1,363 @@
+package tree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// BPlusTree is a B-tree variant that keeps every entry in its leaves.
+// Internal nodes only hold separator keys to route searches, and the leaves
+// are chained left to right, so a range scan finds its first leaf once and
+// then walks the chain without going back up the tree. Nodes use the same
+// minimum degree rule as BTree: between t-1 and 2t-1 keys except the root.
+type BPlusTree[K cmp.Ordered, V any] struct {
+	root   *bplusNode[K, V]
+	degree int
+	size   int
+}
+
+// bplusNode is a leaf when children is nil. In an internal node keys[i]
+// separates children[i], whose keys are all smaller, from children[i+1],
+// whose keys are all greater or equal.
+type bplusNode[K cmp.Ordered, V any] struct {
+	keys     []K
+	values   []V // leaves only
+	children []*bplusNode[K, V]
+	next     *bplusNode[K, V] // next leaf in key order
+}
+
+func (n *bplusNode[K, V]) leaf() bool {
+	return n.children == nil
+}
+
+// childIndex returns the index of the child whose range covers key
+func (n *bplusNode[K, V]) childIndex(key K) int {
+	i, found := slices.BinarySearch(n.keys, key)
+	if found {
+		i++
+	}
+	return i
+}
+
+// NewBPlusTree creates an empty B+tree with the given minimum degree; degree must be at least 2
+func NewBPlusTree[K cmp.Ordered, V any](degree int) *BPlusTree[K, V] {
+	if degree < 2 {
+		panic("tree: B+tree minimum degree must be at least 2")
+	}
+	return &BPlusTree[K, V]{root: &bplusNode[K, V]{}, degree: degree}
+}
+
+// Len returns the number of keys in the tree
+func (t *BPlusTree[K, V]) Len() int {
+	return t.size
+}
+
+// findLeaf returns the leaf whose range covers key
+func (t *BPlusTree[K, V]) findLeaf(key K) *bplusNode[K, V] {
+	n := t.root
+	for !n.leaf() {
+		n = n.children[n.childIndex(key)]
+	}
+	return n
+}
+
+// Get returns the value stored under key and whether it was present
+func (t *BPlusTree[K, V]) Get(key K) (V, bool) {
+	n := t.findLeaf(key)
+	if i, found := slices.BinarySearch(n.keys, key); found {
+		return n.values[i], true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is in the tree
+func (t *BPlusTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Put stores value under key, replacing any previous value. A node that
+// overflows is split in two and the split is passed up to its parent.
+func (t *BPlusTree[K, V]) Put(key K, value V) {
+	right, separator := t.put(t.root, key, value)
+	if right != nil {
+		t.root = &bplusNode[K, V]{
+			keys:     []K{separator},
+			children: []*bplusNode[K, V]{t.root, right},
+		}
+	}
+}
+
+// put inserts into the subtree rooted at n and, if n had to split, returns
+// the new right sibling and the separator key for it
+func (t *BPlusTree[K, V]) put(n *bplusNode[K, V], key K, value V) (*bplusNode[K, V], K) {
+	var zero K
+	if n.leaf() {
+		i, found := slices.BinarySearch(n.keys, key)
+		if found {
+			n.values[i] = value
+			return nil, zero
+		}
+		n.keys = slices.Insert(n.keys, i, key)
+		n.values = slices.Insert(n.values, i, value)
+		t.size++
+		if len(n.keys) < 2*t.degree {
+			return nil, zero
+		}
+		mid := len(n.keys) / 2
+		right := &bplusNode[K, V]{
+			keys:   slices.Clone(n.keys[mid:]),
+			values: slices.Clone(n.values[mid:]),
+			next:   n.next,
+		}
+		n.keys = slices.Clip(n.keys[:mid])
+		n.values = slices.Clip(n.values[:mid])
+		n.next = right
+		return right, right.keys[0]
+	}
+
+	i := n.childIndex(key)
+	right, separator := t.put(n.children[i], key, value)
+	if right == nil {
+		return nil, zero
+	}
+	n.keys = slices.Insert(n.keys, i, separator)
+	n.children = slices.Insert(n.children, i+1, right)
+	if len(n.keys) < 2*t.degree {
+		return nil, zero
+	}
+	// The middle separator moves up instead of being copied
+	mid := len(n.keys) / 2
+	separator = n.keys[mid]
+	right = &bplusNode[K, V]{
+		keys:     slices.Clone(n.keys[mid+1:]),
+		children: slices.Clone(n.children[mid+1:]),
+	}
+	n.keys = slices.Clip(n.keys[:mid])
+	n.children = slices.Clip(n.children[:mid+1])
+	return right, separator
+}
+
+// Delete removes key from the tree and reports whether it was present.
+// A node left with fewer than t-1 keys borrows from a sibling or merges
+// with one, and the fix-up continues upward while parents underflow.
+func (t *BPlusTree[K, V]) Delete(key K) bool {
+	if !t.Contains(key) {
+		return false
+	}
+	t.delete(t.root, key)
+	if len(t.root.keys) == 0 && !t.root.leaf() {
+		t.root = t.root.children[0]
+	}
+	t.size--
+	return true
+}
+
+func (t *BPlusTree[K, V]) delete(n *bplusNode[K, V], key K) {
+	if n.leaf() {
+		i, _ := slices.BinarySearch(n.keys, key)
+		n.keys = slices.Delete(n.keys, i, i+1)
+		n.values = slices.Delete(n.values, i, i+1)
+		return
+	}
+	i := n.childIndex(key)
+	t.delete(n.children[i], key)
+	if len(n.children[i].keys) < t.degree-1 {
+		t.rebalance(n, i)
+	}
+}
+
+// rebalance fixes an underfull n.children[i]
+func (t *BPlusTree[K, V]) rebalance(n *bplusNode[K, V], i int) {
+	child := n.children[i]
+	switch {
+	case i > 0 && len(n.children[i-1].keys) > t.degree-1:
+		left := n.children[i-1]
+		last := len(left.keys) - 1
+		if child.leaf() {
+			child.keys = slices.Insert(child.keys, 0, left.keys[last])
+			child.values = slices.Insert(child.values, 0, left.values[last])
+			left.values = slices.Delete(left.values, last, last+1)
+			n.keys[i-1] = child.keys[0]
+		} else {
+			child.keys = slices.Insert(child.keys, 0, n.keys[i-1])
+			child.children = slices.Insert(child.children, 0, left.children[last+1])
+			left.children = slices.Delete(left.children, last+1, last+2)
+			n.keys[i-1] = left.keys[last]
+		}
+		left.keys = slices.Delete(left.keys, last, last+1)
+	case i < len(n.keys) && len(n.children[i+1].keys) > t.degree-1:
+		right := n.children[i+1]
+		if child.leaf() {
+			child.keys = append(child.keys, right.keys[0])
+			child.values = append(child.values, right.values[0])
+			right.values = slices.Delete(right.values, 0, 1)
+			right.keys = slices.Delete(right.keys, 0, 1)
+			n.keys[i] = right.keys[0]
+		} else {
+			child.keys = append(child.keys, n.keys[i])
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+			n.keys[i] = right.keys[0]
+			right.keys = slices.Delete(right.keys, 0, 1)
+		}
+	case i < len(n.keys):
+		t.merge(n, i)
+	default:
+		t.merge(n, i-1)
+	}
+}
+
+// merge appends n.children[i+1] to n.children[i] and drops the separator
+// between them, which internal nodes pull down as a routing key
+func (t *BPlusTree[K, V]) merge(n *bplusNode[K, V], i int) {
+	left, right := n.children[i], n.children[i+1]
+	if left.leaf() {
+		left.keys = append(left.keys, right.keys...)
+		left.values = append(left.values, right.values...)
+		left.next = right.next
+	} else {
+		left.keys = append(append(left.keys, n.keys[i]), right.keys...)
+		left.children = append(left.children, right.children...)
+	}
+	n.keys = slices.Delete(n.keys, i, i+1)
+	n.children = slices.Delete(n.children, i+1, i+2)
+}
+
+// Min returns the smallest key
+func (t *BPlusTree[K, V]) Min() (K, error) {
+	if t.size == 0 {
+		var zero K
+		return zero, ErrEmpty
+	}
+	return t.firstLeaf().keys[0], nil
+}
+
+// Max returns the largest key
+func (t *BPlusTree[K, V]) Max() (K, error) {
+	if t.size == 0 {
+		var zero K
+		return zero, ErrEmpty
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	return n.keys[len(n.keys)-1], nil
+}
+
+func (t *BPlusTree[K, V]) firstLeaf() *bplusNode[K, V] {
+	n := t.root
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return n
+}
+
+// Range returns an iterator over the entries with lo <= key <= hi in
+// ascending order, walking the leaf chain from the leaf that covers lo
+func (t *BPlusTree[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		n := t.findLeaf(lo)
+		i, _ := slices.BinarySearch(n.keys, lo)
+		for ; n != nil; n, i = n.next, 0 {
+			for ; i < len(n.keys); i++ {
+				if cmp.Less(hi, n.keys[i]) || !yield(n.keys[i], n.values[i]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All returns an iterator over all entries in ascending key order
+func (t *BPlusTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := t.firstLeaf(); n != nil; n = n.next {
+			for i, key := range n.keys {
+				if !yield(key, n.values[i]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Height returns the number of levels in the tree
+func (t *BPlusTree[K, V]) Height() int {
+	h := 1
+	for n := t.root; !n.leaf(); n = n.children[0] {
+		h++
+	}
+	return h
+}
+
+// CheckInvariants verifies separator order, node occupancy, that all leaves
+// are at the same depth and that the leaf chain visits every key in order
+func (t *BPlusTree[K, V]) CheckInvariants() error {
+	var leaves []*bplusNode[K, V]
+	if _, err := t.check(t.root, nil, nil, true, &leaves); err != nil {
+		return err
+	}
+	count := 0
+	for i, leaf := range leaves {
+		var want *bplusNode[K, V]
+		if i+1 < len(leaves) {
+			want = leaves[i+1]
+		}
+		if leaf.next != want {
+			return errors.New("leaf chain is broken")
+		}
+		count += len(leaf.keys)
+	}
+	if count != t.size {
+		return fmt.Errorf("tree holds %d keys but records %d", count, t.size)
+	}
+	return nil
+}
+
+// check returns the height of the subtree rooted at n and collects its
+// leaves in order. Keys must satisfy lo <= key < hi, nil meaning unbounded.
+func (t *BPlusTree[K, V]) check(n *bplusNode[K, V], lo, hi *K, root bool, leaves *[]*bplusNode[K, V]) (int, error) {
+	if len(n.keys) > 2*t.degree-1 || (!root && len(n.keys) < t.degree-1) {
+		return 0, fmt.Errorf("node holds %d keys, want %d to %d", len(n.keys), t.degree-1, 2*t.degree-1)
+	}
+	for i, key := range n.keys {
+		if (i > 0 && cmp.Compare(key, n.keys[i-1]) <= 0) || (lo != nil && cmp.Less(key, *lo)) || (hi != nil && cmp.Compare(key, *hi) >= 0) {
+			return 0, fmt.Errorf("key %v is out of order", key)
+		}
+	}
+	if n.leaf() {
+		if len(n.values) != len(n.keys) {
+			return 0, errors.New("leaf has mismatched keys and values")
+		}
+		*leaves = append(*leaves, n)
+		return 1, nil
+	}
+	if len(n.children) != len(n.keys)+1 {
+		return 0, fmt.Errorf("node with %d keys has %d children", len(n.keys), len(n.children))
+	}
+	height := -1
+	for i, child := range n.children {
+		childLo, childHi := lo, hi
+		if i > 0 {
+			childLo = &n.keys[i-1]
+		}
+		if i < len(n.keys) {
+			childHi = &n.keys[i]
+		}
+		h, err := t.check(child, childLo, childHi, false, leaves)
+		if err != nil {
+			return 0, err
+		}
+		if height != -1 && h != height {
+			return 0, errors.New("leaves are at different depths")
+		}
+		height = h
+	}
+	return height + 1, nil
+}
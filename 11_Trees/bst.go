@@ -1,13 +1,6 @@
-package main
+package tree
 
-import "fmt"
-
-// Node struct for binary search tree
-type Node struct {
-	Value int
-	Left  *Node
-	Right *Node
-}
+import "iter"
 
 // BinarySearchTree struct
 type BinarySearchTree struct {
@@ -19,18 +12,6 @@ func (bst *BinarySearchTree) Insert(value int) {
 	bst.Root = insertNode(bst.Root, value)
 }
 
-func insertNode(node *Node, value int) *Node {
-	if node == nil {
-		return &Node{Value: value}
-	}
-	if value < node.Value {
-		node.Left = insertNode(node.Left, value)
-	} else {
-		node.Right = insertNode(node.Right, value)
-	}
-	return node
-}
-
 // Search searches for a value in the binary search tree
 func (bst *BinarySearchTree) Search(value int) bool {
 	return searchNode(bst.Root, value)
@@ -49,13 +30,9 @@ func searchNode(node *Node, value int) bool {
 	}
 }
 
-func main() {
-	bst := &BinarySearchTree{}
-	bst.Insert(5)
-	bst.Insert(3)
-	bst.Insert(7)
-	bst.Insert(2)
-	bst.Insert(4)
-	fmt.Println("Search 4 in BST:", bst.Search(4))
-	fmt.Println("Search 6 in BST:", bst.Search(6))
+// All returns an iterator over the values in ascending order
+func (bst *BinarySearchTree) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		inOrderSeq(bst.Root, yield)
+	}
 }
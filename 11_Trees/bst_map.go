@@ -0,0 +1,86 @@
+package tree
+
+import "cmp"
+
+// BST is an ordered map backed by an unbalanced binary search tree. Lookups
+// and updates cost O(h) where h is the height of the tree: O(log n) for
+// random keys, but O(n) when keys arrive in sorted order.
+// The zero value is an empty map ready to use.
+type BST[K cmp.Ordered, V any] struct {
+	orderedQueries[K, V]
+}
+
+// Put stores value under key, replacing any previous value
+func (t *BST[K, V]) Put(key K, value V) {
+	t.root = bstPut(t.root, key, value)
+}
+
+func bstPut[K cmp.Ordered, V any](n *mapNode[K, V], key K, value V) *mapNode[K, V] {
+	if n == nil {
+		return &mapNode[K, V]{key: key, value: value, size: 1}
+	}
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = bstPut(n.left, key, value)
+	case c > 0:
+		n.right = bstPut(n.right, key, value)
+	default:
+		n.value = value
+	}
+	n.resize()
+	return n
+}
+
+// Delete removes key from the map and reports whether it was present
+func (t *BST[K, V]) Delete(key K) bool {
+	if getNode(t.root, key) == nil {
+		return false
+	}
+	t.root = bstDelete(t.root, key)
+	return true
+}
+
+// bstDelete removes key from the subtree rooted at n, which must contain it.
+// A node with two children is replaced by its in-order successor, the
+// smallest node of its right subtree.
+func bstDelete[K cmp.Ordered, V any](n *mapNode[K, V], key K) *mapNode[K, V] {
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = bstDelete(n.left, key)
+	case c > 0:
+		n.right = bstDelete(n.right, key)
+	default:
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		successor := minNode(n.right)
+		successor.right = bstDeleteMin(n.right)
+		successor.left = n.left
+		n = successor
+	}
+	n.resize()
+	return n
+}
+
+func bstDeleteMin[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	if n.left == nil {
+		return n.right
+	}
+	n.left = bstDeleteMin(n.left)
+	n.resize()
+	return n
+}
+
+// Height returns the number of nodes on the longest root-to-leaf path
+func (t *BST[K, V]) Height() int {
+	return treeHeight(t.root)
+}
+
+// CheckInvariants verifies key order and subtree sizes, returning a
+// description of the first violation
+func (t *BST[K, V]) CheckInvariants() error {
+	return checkOrder(t.root, nil, nil)
+}
@@ -0,0 +1,354 @@
+package tree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// BTree is an ordered map backed by a B-tree of minimum degree t: every node
+// except the root holds between t-1 and 2t-1 keys, an internal node with k
+// keys has k+1 children, and all leaves sit at the same depth. A wide node
+// is searched with binary search, so a high degree trades a little CPU for a
+// much shallower tree, which is what makes B-trees suit disk pages.
+type BTree[K cmp.Ordered, V any] struct {
+	root   *bNode[K, V]
+	degree int
+	size   int
+}
+
+type bNode[K cmp.Ordered, V any] struct {
+	keys     []K
+	values   []V
+	children []*bNode[K, V] // nil for a leaf
+}
+
+func (n *bNode[K, V]) leaf() bool {
+	return n.children == nil
+}
+
+// NewBTree creates an empty B-tree with the given minimum degree; degree must be at least 2
+func NewBTree[K cmp.Ordered, V any](degree int) *BTree[K, V] {
+	if degree < 2 {
+		panic("tree: B-tree minimum degree must be at least 2")
+	}
+	return &BTree[K, V]{root: &bNode[K, V]{}, degree: degree}
+}
+
+// Len returns the number of keys in the tree
+func (t *BTree[K, V]) Len() int {
+	return t.size
+}
+
+// Get returns the value stored under key and whether it was present
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	n := t.root
+	for {
+		i, found := slices.BinarySearch(n.keys, key)
+		if found {
+			return n.values[i], true
+		}
+		if n.leaf() {
+			var zero V
+			return zero, false
+		}
+		n = n.children[i]
+	}
+}
+
+// Contains reports whether key is in the tree
+func (t *BTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Put stores value under key, replacing any previous value. Full nodes are
+// split on the way down, so there is always room to insert into a leaf.
+func (t *BTree[K, V]) Put(key K, value V) {
+	if len(t.root.keys) == 2*t.degree-1 {
+		root := &bNode[K, V]{children: []*bNode[K, V]{t.root}}
+		t.splitChild(root, 0)
+		t.root = root
+	}
+	n := t.root
+	for {
+		i, found := slices.BinarySearch(n.keys, key)
+		if found {
+			n.values[i] = value
+			return
+		}
+		if n.leaf() {
+			n.keys = slices.Insert(n.keys, i, key)
+			n.values = slices.Insert(n.values, i, value)
+			t.size++
+			return
+		}
+		if len(n.children[i].keys) == 2*t.degree-1 {
+			t.splitChild(n, i)
+			switch c := cmp.Compare(key, n.keys[i]); {
+			case c == 0:
+				n.values[i] = value
+				return
+			case c > 0:
+				i++
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// splitChild splits the full child n.children[i] around its median key,
+// which moves up into n
+func (t *BTree[K, V]) splitChild(n *bNode[K, V], i int) {
+	child := n.children[i]
+	mid := t.degree - 1
+	right := &bNode[K, V]{
+		keys:   slices.Clone(child.keys[mid+1:]),
+		values: slices.Clone(child.values[mid+1:]),
+	}
+	if !child.leaf() {
+		right.children = slices.Clone(child.children[mid+1:])
+		child.children = slices.Clip(child.children[:mid+1])
+	}
+	n.keys = slices.Insert(n.keys, i, child.keys[mid])
+	n.values = slices.Insert(n.values, i, child.values[mid])
+	n.children = slices.Insert(n.children, i+1, right)
+	child.keys = slices.Clip(child.keys[:mid])
+	child.values = slices.Clip(child.values[:mid])
+}
+
+// Delete removes key from the tree and reports whether it was present.
+// Before descending into a child with only t-1 keys the child borrows a key
+// from a sibling or is merged with one, so the key can always be removed
+// without a second pass back up the tree.
+func (t *BTree[K, V]) Delete(key K) bool {
+	if !t.Contains(key) {
+		return false
+	}
+	t.delete(t.root, key)
+	if len(t.root.keys) == 0 && !t.root.leaf() {
+		t.root = t.root.children[0]
+	}
+	t.size--
+	return true
+}
+
+func (t *BTree[K, V]) delete(n *bNode[K, V], key K) {
+	for {
+		i, found := slices.BinarySearch(n.keys, key)
+		if n.leaf() {
+			n.keys = slices.Delete(n.keys, i, i+1)
+			n.values = slices.Delete(n.values, i, i+1)
+			return
+		}
+		if found {
+			switch {
+			case len(n.children[i].keys) >= t.degree:
+				// Replace the key by its predecessor and delete that instead
+				pred := n.children[i]
+				for !pred.leaf() {
+					pred = pred.children[len(pred.children)-1]
+				}
+				last := len(pred.keys) - 1
+				n.keys[i], n.values[i] = pred.keys[last], pred.values[last]
+				key, n = pred.keys[last], n.children[i]
+			case len(n.children[i+1].keys) >= t.degree:
+				succ := n.children[i+1]
+				for !succ.leaf() {
+					succ = succ.children[0]
+				}
+				n.keys[i], n.values[i] = succ.keys[0], succ.values[0]
+				key, n = succ.keys[0], n.children[i+1]
+			default:
+				t.merge(n, i)
+				n = n.children[i]
+			}
+			continue
+		}
+		if len(n.children[i].keys) == t.degree-1 {
+			i = t.fill(n, i)
+		}
+		n = n.children[i]
+	}
+}
+
+// fill gives n.children[i] at least t keys by borrowing from a sibling or
+// merging with one, and returns the index of the child that now covers the
+// original child's keys
+func (t *BTree[K, V]) fill(n *bNode[K, V], i int) int {
+	child := n.children[i]
+	switch {
+	case i > 0 && len(n.children[i-1].keys) >= t.degree:
+		left := n.children[i-1]
+		last := len(left.keys) - 1
+		child.keys = slices.Insert(child.keys, 0, n.keys[i-1])
+		child.values = slices.Insert(child.values, 0, n.values[i-1])
+		n.keys[i-1], n.values[i-1] = left.keys[last], left.values[last]
+		left.keys = slices.Delete(left.keys, last, last+1)
+		left.values = slices.Delete(left.values, last, last+1)
+		if !left.leaf() {
+			child.children = slices.Insert(child.children, 0, left.children[last+1])
+			left.children = slices.Delete(left.children, last+1, last+2)
+		}
+	case i < len(n.keys) && len(n.children[i+1].keys) >= t.degree:
+		right := n.children[i+1]
+		child.keys = append(child.keys, n.keys[i])
+		child.values = append(child.values, n.values[i])
+		n.keys[i], n.values[i] = right.keys[0], right.values[0]
+		right.keys = slices.Delete(right.keys, 0, 1)
+		right.values = slices.Delete(right.values, 0, 1)
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+	case i < len(n.keys):
+		t.merge(n, i)
+	default:
+		t.merge(n, i-1)
+		i--
+	}
+	return i
+}
+
+// merge pulls n.keys[i] down into n.children[i] and appends n.children[i+1] to it
+func (t *BTree[K, V]) merge(n *bNode[K, V], i int) {
+	left, right := n.children[i], n.children[i+1]
+	left.keys = append(append(left.keys, n.keys[i]), right.keys...)
+	left.values = append(append(left.values, n.values[i]), right.values...)
+	if !left.leaf() {
+		left.children = append(left.children, right.children...)
+	}
+	n.keys = slices.Delete(n.keys, i, i+1)
+	n.values = slices.Delete(n.values, i, i+1)
+	n.children = slices.Delete(n.children, i+1, i+2)
+}
+
+// Min returns the smallest key
+func (t *BTree[K, V]) Min() (K, error) {
+	if t.size == 0 {
+		var zero K
+		return zero, ErrEmpty
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return n.keys[0], nil
+}
+
+// Max returns the largest key
+func (t *BTree[K, V]) Max() (K, error) {
+	if t.size == 0 {
+		var zero K
+		return zero, ErrEmpty
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	return n.keys[len(n.keys)-1], nil
+}
+
+// Range returns an iterator over the entries with lo <= key <= hi in ascending order
+func (t *BTree[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		bRangeSeq(t.root, lo, hi, yield)
+	}
+}
+
+// All returns an iterator over all entries in ascending key order
+func (t *BTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		bAllSeq(t.root, yield)
+	}
+}
+
+func bRangeSeq[K cmp.Ordered, V any](n *bNode[K, V], lo, hi K, yield func(K, V) bool) bool {
+	i, _ := slices.BinarySearch(n.keys, lo)
+	for ; i < len(n.keys); i++ {
+		if !n.leaf() && !bRangeSeq(n.children[i], lo, hi, yield) {
+			return false
+		}
+		// Returning false also stops the callers: every key after this one is above hi
+		if cmp.Less(hi, n.keys[i]) || !yield(n.keys[i], n.values[i]) {
+			return false
+		}
+	}
+	return n.leaf() || bRangeSeq(n.children[len(n.keys)], lo, hi, yield)
+}
+
+func bAllSeq[K cmp.Ordered, V any](n *bNode[K, V], yield func(K, V) bool) bool {
+	for i := range n.keys {
+		if !n.leaf() && !bAllSeq(n.children[i], yield) {
+			return false
+		}
+		if !yield(n.keys[i], n.values[i]) {
+			return false
+		}
+	}
+	return n.leaf() || bAllSeq(n.children[len(n.keys)], yield)
+}
+
+// Height returns the number of levels in the tree
+func (t *BTree[K, V]) Height() int {
+	h := 1
+	for n := t.root; !n.leaf(); n = n.children[0] {
+		h++
+	}
+	return h
+}
+
+// CheckInvariants verifies key order, node occupancy, child counts and that
+// all leaves are at the same depth
+func (t *BTree[K, V]) CheckInvariants() error {
+	count, _, err := t.check(t.root, nil, nil, true)
+	if err != nil {
+		return err
+	}
+	if count != t.size {
+		return fmt.Errorf("tree holds %d keys but records %d", count, t.size)
+	}
+	return nil
+}
+
+// check returns the number of keys and the height of the subtree rooted at n
+func (t *BTree[K, V]) check(n *bNode[K, V], lo, hi *K, root bool) (int, int, error) {
+	if len(n.keys) > 2*t.degree-1 || (!root && len(n.keys) < t.degree-1) {
+		return 0, 0, fmt.Errorf("node holds %d keys, want %d to %d", len(n.keys), t.degree-1, 2*t.degree-1)
+	}
+	if len(n.values) != len(n.keys) {
+		return 0, 0, errors.New("node has mismatched keys and values")
+	}
+	for i, key := range n.keys {
+		if (i > 0 && cmp.Compare(key, n.keys[i-1]) <= 0) || (lo != nil && cmp.Compare(key, *lo) <= 0) || (hi != nil && cmp.Compare(key, *hi) >= 0) {
+			return 0, 0, fmt.Errorf("key %v is out of order", key)
+		}
+	}
+	if n.leaf() {
+		return len(n.keys), 1, nil
+	}
+	if len(n.children) != len(n.keys)+1 {
+		return 0, 0, fmt.Errorf("node with %d keys has %d children", len(n.keys), len(n.children))
+	}
+	count, height := len(n.keys), -1
+	for i, child := range n.children {
+		childLo, childHi := lo, hi
+		if i > 0 {
+			childLo = &n.keys[i-1]
+		}
+		if i < len(n.keys) {
+			childHi = &n.keys[i]
+		}
+		c, h, err := t.check(child, childLo, childHi, false)
+		if err != nil {
+			return 0, 0, err
+		}
+		if height != -1 && h != height {
+			return 0, 0, errors.New("leaves are at different depths")
+		}
+		count, height = count+c, h
+	}
+	return count, height + 1, nil
+}
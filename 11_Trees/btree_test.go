@@ -0,0 +1,250 @@
+package tree
+
+import (
+	"errors"
+	"iter"
+	"math"
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// multiwayTree is the API shared by BTree and BPlusTree
+type multiwayTree interface {
+	Put(key, value int)
+	Get(key int) (int, bool)
+	Delete(key int) bool
+	Contains(key int) bool
+	Len() int
+	Min() (int, error)
+	Max() (int, error)
+	Range(lo, hi int) iter.Seq2[int, int]
+	All() iter.Seq2[int, int]
+	CheckInvariants() error
+}
+
+var multiwayTrees = []struct {
+	name string
+	make func(degree int) multiwayTree
+}{
+	{"B-tree", func(degree int) multiwayTree { return NewBTree[int, int](degree) }},
+	{"B+tree", func(degree int) multiwayTree { return NewBPlusTree[int, int](degree) }},
+}
+
+// compareMultiway checks the queries of m against the oracle around key
+func compareMultiway(t *testing.T, m multiwayTree, o *mapOracle, key int) {
+	t.Helper()
+	if m.Len() != len(o.keys) {
+		t.Fatalf("Len() = %d, want %d", m.Len(), len(o.keys))
+	}
+	want, wantOK := o.values[key]
+	if got, ok := m.Get(key); ok != wantOK || got != want {
+		t.Fatalf("Get(%d) = %d, %v; want %d, %v", key, got, ok, want, wantOK)
+	}
+	if m.Contains(key) != wantOK {
+		t.Fatalf("Contains(%d) = %v, want %v", key, !wantOK, wantOK)
+	}
+	if len(o.keys) == 0 {
+		if _, err := m.Min(); !errors.Is(err, ErrEmpty) {
+			t.Fatalf("Min() of empty tree: err = %v, want ErrEmpty", err)
+		}
+		if _, err := m.Max(); !errors.Is(err, ErrEmpty) {
+			t.Fatalf("Max() of empty tree: err = %v, want ErrEmpty", err)
+		}
+	} else {
+		if got, _ := m.Min(); got != o.keys[0] {
+			t.Fatalf("Min() = %d, want %d", got, o.keys[0])
+		}
+		if got, _ := m.Max(); got != o.keys[len(o.keys)-1] {
+			t.Fatalf("Max() = %d, want %d", got, o.keys[len(o.keys)-1])
+		}
+	}
+	lo, hi := key-15, key+15
+	var got, wantKeys []int
+	for k, v := range m.Range(lo, hi) {
+		if v != o.values[k] {
+			t.Fatalf("Range(%d, %d) yielded %d=%d, want %d", lo, hi, k, v, o.values[k])
+		}
+		got = append(got, k)
+	}
+	for _, k := range o.keys {
+		if lo <= k && k <= hi {
+			wantKeys = append(wantKeys, k)
+		}
+	}
+	if !slices.Equal(got, wantKeys) {
+		t.Fatalf("Range(%d, %d) = %v, want %v", lo, hi, got, wantKeys)
+	}
+}
+
+// TestMultiwayTreesAgainstOracle runs random puts and deletes at small
+// degrees, where nodes split, borrow and merge often, checking the
+// invariants after every operation
+func TestMultiwayTreesAgainstOracle(t *testing.T) {
+	for _, tt := range multiwayTrees {
+		for _, degree := range []int{2, 3, 5} {
+			t.Run(tt.name+"/t="+strconv.Itoa(degree), func(t *testing.T) {
+				rng := rand.New(rand.NewSource(int64(degree)))
+				for range 3 {
+					m := tt.make(degree)
+					o := &mapOracle{values: map[int]int{}}
+					for step := range 3000 {
+						key := rng.Intn(400)
+						// start with mostly inserts, then drain the tree
+						if (step < 2000 && rng.Intn(3) > 0) || (step >= 2000 && rng.Intn(4) == 0) {
+							m.Put(key, step)
+							o.put(key, step)
+						} else if got, want := m.Delete(key), o.delete(key); got != want {
+							t.Fatalf("step %d: Delete(%d) = %v, want %v", step, key, got, want)
+						}
+						if err := m.CheckInvariants(); err != nil {
+							t.Fatalf("step %d: %v", step, err)
+						}
+						compareMultiway(t, m, o, rng.Intn(440)-20)
+					}
+					var all []int
+					for k := range m.All() {
+						all = append(all, k)
+					}
+					if !slices.Equal(all, o.keys) {
+						t.Fatalf("All() = %v, want %v", all, o.keys)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestMultiwayTreesSortedKeys(t *testing.T) {
+	const n = 2000
+	for _, tt := range multiwayTrees {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.make(2)
+			for key := range n {
+				m.Put(key, key)
+			}
+			if err := m.CheckInvariants(); err != nil {
+				t.Fatal(err)
+			}
+			// delete from both ends towards the middle
+			for i := range n / 2 {
+				for _, key := range []int{i, n - 1 - i} {
+					if !m.Delete(key) {
+						t.Fatalf("Delete(%d) did not find the key", key)
+					}
+				}
+				if err := m.CheckInvariants(); err != nil {
+					t.Fatalf("after deleting %d keys: %v", 2*(i+1), err)
+				}
+			}
+			if m.Len() != 0 {
+				t.Fatalf("Len() = %d after deleting every key", m.Len())
+			}
+			// an emptied tree is usable again
+			m.Put(1, 1)
+			if got, ok := m.Get(1); !ok || got != 1 {
+				t.Fatalf("Get(1) after refilling = %d, %v", got, ok)
+			}
+		})
+	}
+}
+
+func TestMultiwayTreesStopEarly(t *testing.T) {
+	for _, tt := range multiwayTrees {
+		m := tt.make(2)
+		for key := range 100 {
+			m.Put(key, key)
+		}
+		var got []int
+		for k := range m.Range(10, 90) {
+			if k == 20 {
+				break
+			}
+			got = append(got, k)
+		}
+		if want := []int{10, 11, 12, 13, 14, 15, 16, 17, 18, 19}; !slices.Equal(got, want) {
+			t.Fatalf("%s: Range stopped at 20 yielded %v, want %v", tt.name, got, want)
+		}
+		got = got[:0]
+		for k := range m.All() {
+			if k == 3 {
+				break
+			}
+			got = append(got, k)
+		}
+		if want := []int{0, 1, 2}; !slices.Equal(got, want) {
+			t.Fatalf("%s: All stopped at 3 yielded %v, want %v", tt.name, got, want)
+		}
+	}
+}
+
+func TestMultiwayTreesRejectDegreeOne(t *testing.T) {
+	for _, tt := range multiwayTrees {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s with degree 1 did not panic", tt.name)
+				}
+			}()
+			tt.make(1)
+		}()
+	}
+}
+
+// TestMultiwayTreesNaNKeys checks that NaN keys sort as cmp.Compare orders
+// them, before every other key, in lookups, range scans and invariant checks
+func TestMultiwayTreesNaNKeys(t *testing.T) {
+	type floatTree interface {
+		Put(key float64, value int)
+		Delete(key float64) bool
+		Contains(key float64) bool
+		Range(lo, hi float64) iter.Seq2[float64, int]
+		All() iter.Seq2[float64, int]
+		CheckInvariants() error
+	}
+	trees := []struct {
+		name string
+		make func() floatTree
+	}{
+		{"B-tree", func() floatTree { return NewBTree[float64, int](2) }},
+		{"B+tree", func() floatTree { return NewBPlusTree[float64, int](2) }},
+	}
+	nan := math.NaN()
+	keys := func(seq iter.Seq2[float64, int]) []float64 {
+		var got []float64
+		for k := range seq {
+			got = append(got, k)
+		}
+		return got
+	}
+	for _, tt := range trees {
+		m := tt.make()
+		for i := range 20 {
+			m.Put(float64(i), i)
+		}
+		m.Put(nan, -1)
+		m.Put(nan, -2)
+		if err := m.CheckInvariants(); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if !m.Contains(nan) {
+			t.Fatalf("%s: Contains(NaN) = false after Put(NaN)", tt.name)
+		}
+		if got := keys(m.Range(nan, nan)); !sameFloats(got, []float64{nan}) {
+			t.Fatalf("%s: Range(NaN, NaN) = %v, want [NaN]", tt.name, got)
+		}
+		if got := keys(m.Range(nan, 1)); !sameFloats(got, []float64{nan, 0, 1}) {
+			t.Fatalf("%s: Range(NaN, 1) = %v, want [NaN 0 1]", tt.name, got)
+		}
+		if got := keys(m.All()); len(got) != 21 || !math.IsNaN(got[0]) {
+			t.Fatalf("%s: All() = %v, want NaN first of 21 keys", tt.name, got)
+		}
+		if !m.Delete(nan) || m.Delete(nan) || m.Contains(nan) {
+			t.Fatalf("%s: Delete(NaN) did not remove the key exactly once", tt.name)
+		}
+		if err := m.CheckInvariants(); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+
+	stackqueue "github.com/kuldeep-bishnoi/Golang-DSA/10_Stacks_Queues"
+)
+
+// ErrTraversals is returned when traversals do not describe a single binary
+// tree with distinct values
+var ErrTraversals = errors.New("tree: traversals do not describe a binary tree with distinct values")
+
+// inorderPositions maps every value of inorder to its index, rejecting duplicates
+func inorderPositions(inorder []int) (map[int]int, error) {
+	positions := make(map[int]int, len(inorder))
+	for i, value := range inorder {
+		if _, dup := positions[value]; dup {
+			return nil, ErrTraversals
+		}
+		positions[value] = i
+	}
+	return positions, nil
+}
+
+// FromPreorderInorder rebuilds a tree with distinct values from its pre-order
+// and in-order traversals. The next pre-order value is the root of the
+// current subtree, and its position in the in-order traversal splits the
+// remaining values into the left and right subtrees.
+func FromPreorderInorder(preorder, inorder []int) (*BinaryTree, error) {
+	if len(preorder) != len(inorder) {
+		return nil, ErrTraversals
+	}
+	positions, err := inorderPositions(inorder)
+	if err != nil {
+		return nil, err
+	}
+	next := 0
+	var build func(lo, hi int) (*Node, error)
+	build = func(lo, hi int) (*Node, error) {
+		if lo > hi {
+			return nil, nil
+		}
+		value := preorder[next]
+		mid, ok := positions[value]
+		if !ok || mid < lo || mid > hi {
+			return nil, ErrTraversals
+		}
+		next++
+		node := &Node{Value: value}
+		if node.Left, err = build(lo, mid-1); err != nil {
+			return nil, err
+		}
+		if node.Right, err = build(mid+1, hi); err != nil {
+			return nil, err
+		}
+		return node, nil
+	}
+	root, err := build(0, len(inorder)-1)
+	if err != nil {
+		return nil, err
+	}
+	return &BinaryTree{Root: root}, nil
+}
+
+// FromPostorderInorder rebuilds a tree with distinct values from its
+// post-order and in-order traversals, reading the post-order traversal from
+// the end so every value is the root of the current subtree, right subtree
+// first
+func FromPostorderInorder(postorder, inorder []int) (*BinaryTree, error) {
+	if len(postorder) != len(inorder) {
+		return nil, ErrTraversals
+	}
+	positions, err := inorderPositions(inorder)
+	if err != nil {
+		return nil, err
+	}
+	next := len(postorder) - 1
+	var build func(lo, hi int) (*Node, error)
+	build = func(lo, hi int) (*Node, error) {
+		if lo > hi {
+			return nil, nil
+		}
+		value := postorder[next]
+		mid, ok := positions[value]
+		if !ok || mid < lo || mid > hi {
+			return nil, ErrTraversals
+		}
+		next--
+		node := &Node{Value: value}
+		if node.Right, err = build(mid+1, hi); err != nil {
+			return nil, err
+		}
+		if node.Left, err = build(lo, mid-1); err != nil {
+			return nil, err
+		}
+		return node, nil
+	}
+	root, err := build(0, len(inorder)-1)
+	if err != nil {
+		return nil, err
+	}
+	return &BinaryTree{Root: root}, nil
+}
+
+var errLevelOrderExtra = fmt.Errorf("%w: level-order array has values after the last node's children", ErrSyntax)
+
+// FromLevelOrder builds a tree from a level-order array in which nil marks
+// a missing child, as in [1, 2, nil, 3]. Only the children of present nodes
+// are listed, so the array describes the tree without ambiguity.
+func FromLevelOrder(values []*int) (*BinaryTree, error) {
+	if len(values) == 0 || values[0] == nil {
+		if len(values) > 1 {
+			return nil, errLevelOrderExtra
+		}
+		return &BinaryTree{}, nil
+	}
+	root := &Node{Value: *values[0]}
+	parents := []*Node{root}
+	i := 1
+	for ; i < len(values) && len(parents) > 0; i += 2 {
+		parent := parents[0]
+		parents = parents[1:]
+		if values[i] != nil {
+			parent.Left = &Node{Value: *values[i]}
+			parents = append(parents, parent.Left)
+		}
+		if i+1 < len(values) && values[i+1] != nil {
+			parent.Right = &Node{Value: *values[i+1]}
+			parents = append(parents, parent.Right)
+		}
+	}
+	if i < len(values) {
+		return nil, errLevelOrderExtra
+	}
+	return &BinaryTree{Root: root}, nil
+}
+
+// LevelOrderArray returns the level-order array form of the tree accepted by
+// FromLevelOrder, with trailing nil markers trimmed
+func (bt *BinaryTree) LevelOrderArray() []*int {
+	var values []*int
+	if bt.Root == nil {
+		return values
+	}
+	queue := &stackqueue.Queue[*Node]{}
+	queue.Enqueue(bt.Root)
+	for !queue.IsEmpty() {
+		node, _ := queue.Dequeue()
+		if node == nil {
+			values = append(values, nil)
+			continue
+		}
+		value := node.Value
+		values = append(values, &value)
+		queue.Enqueue(node.Left)
+		queue.Enqueue(node.Right)
+	}
+	for values[len(values)-1] == nil {
+		values = values[:len(values)-1]
+	}
+	return values
+}
@@ -0,0 +1,86 @@
+package tree
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+// distinctTree builds a random tree of n nodes and relabels it with the
+// distinct values of a random permutation, as the rebuilders require
+func distinctTree(rng *rand.Rand, n int) *Node {
+	root := randomTree(rng, n, 0)
+	values := rng.Perm(3 * n)
+	var relabel func(node *Node)
+	relabel = func(node *Node) {
+		if node == nil {
+			return
+		}
+		node.Value, values = values[0]-n, values[1:]
+		relabel(node.Left)
+		relabel(node.Right)
+	}
+	relabel(root)
+	return root
+}
+
+func TestRebuildFromTraversals(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 500 {
+		want := distinctTree(rng, rng.Intn(40))
+		inorder := recursiveInOrder(want, nil)
+		got, err := FromPreorderInorder(recursivePreOrder(want, nil), inorder)
+		if err != nil || !sameShape(got.Root, want) {
+			t.Fatalf("FromPreorderInorder did not rebuild %s: %v", (&BinaryTree{Root: want}).FormatParens(), err)
+		}
+		got, err = FromPostorderInorder(recursivePostOrder(want, nil), inorder)
+		if err != nil || !sameShape(got.Root, want) {
+			t.Fatalf("FromPostorderInorder did not rebuild %s: %v", (&BinaryTree{Root: want}).FormatParens(), err)
+		}
+	}
+}
+
+func TestRebuildRejectsBadTraversals(t *testing.T) {
+	tests := []struct {
+		name               string
+		pre, post, inorder []int
+	}{
+		{"longer order", []int{1, 2, 3}, []int{3, 2, 1}, []int{2, 1}},
+		{"shorter order", []int{1}, []int{1}, []int{2, 1}},
+		{"duplicate in-order values", []int{1, 1}, []int{1, 1}, []int{1, 1}},
+		{"duplicate order values", []int{1, 1, 2}, []int{2, 1, 1}, []int{1, 2, 3}},
+		{"value missing from in-order", []int{1, 4}, []int{4, 1}, []int{1, 2}},
+		// the root 2 puts 1 on its left and 3 on its right, so the next
+		// subtree root is the wrong value in both orders
+		{"inconsistent orders", []int{2, 3, 1}, []int{3, 1, 2}, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		if _, err := FromPreorderInorder(tt.pre, tt.inorder); !errors.Is(err, ErrTraversals) {
+			t.Errorf("%s: FromPreorderInorder err = %v, want ErrTraversals", tt.name, err)
+		}
+		if _, err := FromPostorderInorder(tt.post, tt.inorder); !errors.Is(err, ErrTraversals) {
+			t.Errorf("%s: FromPostorderInorder err = %v, want ErrTraversals", tt.name, err)
+		}
+	}
+	// no traversals at all describe the empty tree
+	for _, build := range []func(a, b []int) (*BinaryTree, error){FromPreorderInorder, FromPostorderInorder} {
+		if bt, err := build(nil, nil); err != nil || bt.Root != nil {
+			t.Errorf("rebuilding from empty traversals = %v, %v; want the empty tree", bt, err)
+		}
+	}
+}
+
+func TestLevelOrderArrayRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 500 {
+		want := randomTree(rng, rng.Intn(40), 50)
+		values := (&BinaryTree{Root: want}).LevelOrderArray()
+		if len(values) > 0 && values[len(values)-1] == nil {
+			t.Fatal("LevelOrderArray kept a trailing nil")
+		}
+		got, err := FromLevelOrder(values)
+		if err != nil || !sameShape(got.Root, want) {
+			t.Fatalf("FromLevelOrder did not rebuild %s: %v", (&BinaryTree{Root: want}).FormatParens(), err)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package diskbtree
+
+import "slices"
+
+// tx is a single copy-on-write update. Pages of the committed tree are never
+// overwritten: every node on the path to a change is written to a fresh page
+// and its old page is released, so until the new meta page is written the
+// file still holds the previous tree intact.
+type tx struct {
+	pager   *pager
+	meta    meta
+	written []pgid // pages written by this transaction, dropped from the cache on rollback
+}
+
+// child refers to a rewritten subtree. key is the separator that goes in
+// front of it in the parent, nil for the first of a group.
+type child struct {
+	key  []byte
+	id   pgid
+	size int
+}
+
+// rewrite releases page old (unless it is 0) and writes n in its place,
+// splitting it into several pages if it no longer fits in one
+func (tx *tx) rewrite(old pgid, n *node) ([]child, error) {
+	if old != 0 {
+		tx.pager.release(old)
+	}
+	var kids []child
+	for _, part := range tx.split(n, nil) {
+		id := tx.pager.allocate(&tx.meta)
+		tx.written = append(tx.written, id)
+		if err := tx.pager.write(id, part.node); err != nil {
+			return nil, err
+		}
+		kids = append(kids, child{key: part.key, id: id, size: part.node.size()})
+	}
+	return kids, nil
+}
+
+type splitPart struct {
+	key  []byte
+	node *node
+}
+
+// split cuts n into pieces that each fit in a page, cutting at the entry
+// closest to the middle by bytes. key is the separator in front of n.
+func (tx *tx) split(n *node, key []byte) []splitPart {
+	size := n.size()
+	if size <= tx.pager.pageSize || len(n.keys) < 3 {
+		return []splitPart{{key: key, node: n}}
+	}
+	half, acc, j := size/2, pageHeaderSize, 1
+	for ; j < len(n.keys)-2; j++ {
+		if acc += n.entrySize(j - 1); acc >= half {
+			break
+		}
+	}
+	var left, right *node
+	var separator []byte
+	if n.leaf {
+		left = &node{leaf: true, keys: slices.Clip(n.keys[:j]), values: slices.Clip(n.values[:j])}
+		right = &node{leaf: true, keys: n.keys[j:], values: n.values[j:]}
+		separator = n.keys[j]
+	} else {
+		// The separator moves up to the parent instead of being copied
+		left = &node{keys: slices.Clip(n.keys[:j]), children: slices.Clip(n.children[:j+1])}
+		right = &node{keys: n.keys[j+1:], children: n.children[j+1:]}
+		separator = n.keys[j]
+	}
+	return append(tx.split(left, key), tx.split(right, separator)...)
+}
+
+// replaceChild swaps children[i] of a branch for the rewritten subtrees in kids
+func (n *node) replaceChild(i int, kids []child) {
+	n.children[i] = kids[0].id
+	for k, kid := range kids[1:] {
+		n.keys = slices.Insert(n.keys, i+k, kid.key)
+		n.children = slices.Insert(n.children, i+k+1, kid.id)
+	}
+}
+
+// put stores value under key in the subtree rooted at page id and reports
+// whether the key is new
+func (tx *tx) put(id pgid, key, value []byte) ([]child, bool, error) {
+	n, err := tx.pager.node(id)
+	if err != nil {
+		return nil, false, err
+	}
+	n = n.clone()
+	added := false
+	if n.leaf {
+		i, found := n.search(key)
+		if found {
+			n.values[i] = value
+		} else {
+			n.keys = slices.Insert(n.keys, i, key)
+			n.values = slices.Insert(n.values, i, value)
+			added = true
+		}
+	} else {
+		i := n.childIndex(key)
+		var kids []child
+		if kids, added, err = tx.put(n.children[i], key, value); err != nil {
+			return nil, false, err
+		}
+		n.replaceChild(i, kids)
+	}
+	kids, err := tx.rewrite(id, n)
+	return kids, added, err
+}
+
+// remove deletes key, which must be present, from the subtree rooted at
+// page id. A child left less than a quarter full is merged with a sibling,
+// and the merged node is split again if it does not fit in one page.
+func (tx *tx) remove(id pgid, key []byte) ([]child, error) {
+	n, err := tx.pager.node(id)
+	if err != nil {
+		return nil, err
+	}
+	n = n.clone()
+	if n.leaf {
+		i, _ := n.search(key)
+		n.keys = slices.Delete(n.keys, i, i+1)
+		n.values = slices.Delete(n.values, i, i+1)
+		return tx.rewrite(id, n)
+	}
+	i := n.childIndex(key)
+	kids, err := tx.remove(n.children[i], key)
+	if err != nil {
+		return nil, err
+	}
+	n.replaceChild(i, kids)
+	if len(kids) == 1 && kids[0].size < tx.pager.pageSize/4 && len(n.children) > 1 {
+		if err := tx.merge(n, max(i-1, 0)); err != nil {
+			return nil, err
+		}
+	}
+	return tx.rewrite(id, n)
+}
+
+// merge joins children[j] and children[j+1] of branch n into one node
+func (tx *tx) merge(n *node, j int) error {
+	left, err := tx.pager.node(n.children[j])
+	if err != nil {
+		return err
+	}
+	right, err := tx.pager.node(n.children[j+1])
+	if err != nil {
+		return err
+	}
+	merged := left.clone()
+	merged.keys = append(merged.keys, right.keys...)
+	if merged.leaf {
+		merged.values = append(merged.values, right.values...)
+	} else {
+		merged.keys = slices.Insert(merged.keys, len(left.keys), n.keys[j])
+		merged.children = append(merged.children, right.children...)
+	}
+	tx.pager.release(n.children[j+1])
+	kids, err := tx.rewrite(n.children[j], merged)
+	if err != nil {
+		return err
+	}
+	n.keys = slices.Delete(n.keys, j, j+1)
+	n.children = slices.Delete(n.children, j+1, j+2)
+	n.replaceChild(j, kids)
+	return nil
+}
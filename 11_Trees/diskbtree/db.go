@@ -0,0 +1,476 @@
+// Package diskbtree is a B+tree of byte-slice keys and values stored in a
+// single page file.
+//
+// Updates are copy-on-write: a Put or Delete writes the changed nodes to free
+// pages, syncs them, and only then commits by writing a new meta page that
+// points at the new root. The two meta pages at the start of the file are
+// used alternately and carry a checksum, so a crash at any point leaves at
+// least one intact meta page describing a complete tree, and Open picks the
+// newest one whose pages are all present.
+package diskbtree
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+)
+
+var (
+	// ErrCorrupt is returned when the file holds no intact tree
+	ErrCorrupt = errors.New("diskbtree: file is corrupt")
+	// ErrNotFound is returned by Get for a missing key
+	ErrNotFound = errors.New("diskbtree: key not found")
+	// ErrTooLarge is returned when a key and value do not fit in a quarter of a page
+	ErrTooLarge = errors.New("diskbtree: key and value too large for page size")
+	// ErrClosed is returned when using a DB after Close
+	ErrClosed = errors.New("diskbtree: database is closed")
+)
+
+const defaultCacheSize = 256
+
+// Options configures how a DB is opened. A nil *Options uses the defaults.
+type Options struct {
+	// PageSize is the page size for a new file, a power of two between 256
+	// and 65536; an existing file keeps the page size it was created with.
+	// Defaults to DefaultPageSize.
+	PageSize int
+	// CacheSize is the number of decoded pages kept in memory. Defaults to 256.
+	CacheSize int
+}
+
+// DB is a B+tree stored in a page file. It is safe for concurrent use;
+// operations are serialized.
+type DB struct {
+	mu     sync.Mutex
+	file   File
+	pager  *pager
+	meta   meta
+	closed bool
+	// failed is set when a commit fails after it started writing the meta
+	// page; the file is then in an unknown state and the DB refuses writes
+	failed error
+}
+
+// Open opens or creates the page file at path
+func Open(path string, opts *Options) (*DB, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	db, err := OpenFile(file, opts)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// OpenFile opens a DB stored in file, initializing the file if it is empty
+// or a crash interrupted its first commit. It reads every page of the newest
+// intact tree to rebuild the free list, so pages left behind by an
+// interrupted update are reclaimed. The DB takes ownership of file and
+// closes it on Close.
+func OpenFile(file File, opts *Options) (*DB, error) {
+	pageSize, cacheSize := DefaultPageSize, defaultCacheSize
+	if opts != nil && opts.PageSize != 0 {
+		pageSize = opts.PageSize
+	}
+	if opts != nil && opts.CacheSize > 0 {
+		cacheSize = opts.CacheSize
+	}
+	if !validPageSize(pageSize) {
+		return nil, fmt.Errorf("diskbtree: page size must be a power of two between %d and %d", minPageSize, maxPageSize)
+	}
+
+	db := &DB{file: file}
+	metas, blank, err := readMetas(file, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(metas) == 0 {
+		if !blank {
+			return nil, fmt.Errorf("%w: no valid meta page", ErrCorrupt)
+		}
+		return db, db.initialize(pageSize, cacheSize)
+	}
+	// Fall back to the older meta page if the newer one's tree did not make
+	// it to disk completely
+	for _, m := range metas {
+		if err = db.load(m, cacheSize); err == nil {
+			return db, nil
+		}
+	}
+	return nil, err
+}
+
+// readMetas returns the valid meta pages, newest first, and whether the file
+// never had a commit finish
+func readMetas(file File, pageSize int) ([]meta, bool, error) {
+	var metas []meta
+	first, ok, head, err := readMeta(file, 0)
+	if err != nil {
+		return nil, false, err
+	}
+	blank := !ok && unfinishedInit(head)
+	// The second meta page sits one page into the file, but the page size
+	// is only recorded in the meta pages themselves. If the first one is
+	// torn, look for the second at every page size a file can have.
+	offsets := []int{pageSize}
+	if ok {
+		metas = append(metas, first)
+		offsets[0] = int(first.pageSize)
+	} else {
+		for size := minPageSize; size <= maxPageSize; size *= 2 {
+			if size != pageSize {
+				offsets = append(offsets, size)
+			}
+		}
+	}
+	for _, offset := range offsets {
+		m, ok, _, err := readMeta(file, int64(offset))
+		if err != nil {
+			return nil, false, err
+		}
+		if ok && int(m.pageSize) == offset {
+			metas = append(metas, m)
+			break
+		}
+	}
+	slices.SortFunc(metas, func(a, b meta) int { return cmp.Compare(b.txid, a.txid) })
+	return metas, blank, nil
+}
+
+// readMeta decodes the meta page at offset, reporting whether it is valid
+// and returning the bytes read
+func readMeta(file File, offset int64) (meta, bool, []byte, error) {
+	buf := make([]byte, metaSize)
+	n, err := file.ReadAt(buf, offset)
+	if n < len(buf) && !errors.Is(err, io.EOF) {
+		return meta{}, false, nil, err
+	}
+	m, err := decodeMeta(buf[:n])
+	return m, err == nil, buf[:n], nil
+}
+
+// unfinishedInit reports whether head, the start of the first meta page, is
+// blank or a torn write of the meta page initialize commits. Nothing else is
+// written there until a commit has finished, so such a file holds no data.
+// Only the page written before the meta page, the empty root, may be there.
+func unfinishedInit(head []byte) bool {
+	written := bytes.TrimRight(head, "\x00")
+	if len(written) == 0 {
+		return true
+	}
+	buf := make([]byte, metaSize)
+	for size := minPageSize; size <= maxPageSize; size *= 2 {
+		m := initialMeta(size)
+		m.encode(buf)
+		if bytes.HasPrefix(buf, written) {
+			return true
+		}
+	}
+	return false
+}
+
+// initialMeta describes the empty tree initialize commits
+func initialMeta(pageSize int) meta {
+	return meta{pageSize: uint32(pageSize), root: 2, pageCount: 3}
+}
+
+// initialize writes an empty leaf as the root and commits it as transaction 0
+func (db *DB) initialize(pageSize, cacheSize int) error {
+	db.pager = newPager(db.file, pageSize, cacheSize)
+	m := initialMeta(pageSize)
+	if err := db.pager.write(m.root, &node{leaf: true}); err != nil {
+		return err
+	}
+	if err := db.file.Sync(); err != nil {
+		return err
+	}
+	if err := db.writeMeta(m); err != nil {
+		return err
+	}
+	db.meta = m
+	return nil
+}
+
+// load checks that the tree described by m is complete and readable, then
+// makes every page it does not use free
+func (db *DB) load(m meta, cacheSize int) error {
+	p := newPager(db.file, int(m.pageSize), cacheSize)
+	// A file is only ever cut short at the end, so if the last page is
+	// there, so are all the pages before it
+	if err := p.readPage(pgid(m.pageCount-1), make([]byte, m.pageSize)); err != nil {
+		return err
+	}
+	used := make([]bool, m.pageCount)
+	used[0], used[1] = true, true
+	count, _, err := walk(p, m.root, used)
+	if err != nil {
+		return err
+	}
+	if count != m.count {
+		return fmt.Errorf("%w: tree holds %d keys, meta page records %d", ErrCorrupt, count, m.count)
+	}
+	for id := len(used) - 1; id >= 2; id-- {
+		if !used[id] {
+			p.free = append(p.free, pgid(id))
+		}
+	}
+	db.pager, db.meta = p, m
+	return nil
+}
+
+// walk visits every page of the subtree rooted at id, marking it used, and
+// returns the number of keys and the depth of its leaves
+func walk(p *pager, id pgid, used []bool) (uint64, int, error) {
+	if id < 2 || uint64(id) >= uint64(len(used)) || used[id] {
+		return 0, 0, fmt.Errorf("%w: invalid reference to page %d", ErrCorrupt, id)
+	}
+	used[id] = true
+	n, err := p.node(id)
+	if err != nil {
+		return 0, 0, err
+	}
+	if n.leaf {
+		return uint64(len(n.keys)), 1, nil
+	}
+	var total uint64
+	depth := -1
+	for _, childID := range n.children {
+		count, d, err := walk(p, childID, used)
+		if err != nil {
+			return 0, 0, err
+		}
+		if depth != -1 && d != depth {
+			return 0, 0, fmt.Errorf("%w: leaves at different depths", ErrCorrupt)
+		}
+		total, depth = total+count, d
+	}
+	return total, depth + 1, nil
+}
+
+// writeMeta writes m to the meta slot chosen by its transaction id and syncs it
+func (db *DB) writeMeta(m meta) error {
+	buf := make([]byte, db.pager.pageSize)
+	m.encode(buf)
+	if _, err := db.file.WriteAt(buf, int64(m.txid%2)*int64(db.pager.pageSize)); err != nil {
+		return err
+	}
+	return db.file.Sync()
+}
+
+// update runs fn as a transaction and commits it. If fn or writing the new
+// pages fails the transaction is rolled back and the DB is left unchanged.
+func (db *DB) update(fn func(tx *tx) error) error {
+	if db.failed != nil {
+		return db.failed
+	}
+	saved := slices.Clone(db.pager.free)
+	tx := &tx{pager: db.pager, meta: db.meta}
+	err := fn(tx)
+	if err == nil {
+		err = db.file.Sync()
+	}
+	if err != nil {
+		for _, id := range tx.written {
+			db.pager.forget(id)
+		}
+		db.pager.free = saved
+		db.pager.pending = db.pager.pending[:0]
+		return err
+	}
+	tx.meta.txid++
+	if err := db.writeMeta(tx.meta); err != nil {
+		db.failed = fmt.Errorf("diskbtree: commit failed, reopen the file: %w", err)
+		return db.failed
+	}
+	db.meta = tx.meta
+	db.pager.commit()
+	return nil
+}
+
+func (db *DB) check() error {
+	if db.closed {
+		return ErrClosed
+	}
+	return nil
+}
+
+// Get returns the value stored under key, or ErrNotFound
+func (db *DB) Get(key []byte) ([]byte, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if err := db.check(); err != nil {
+		return nil, err
+	}
+	n, found, err := db.find(key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrNotFound
+	}
+	i, _ := n.search(key)
+	return bytes.Clone(n.values[i]), nil
+}
+
+// find returns the leaf whose range covers key and whether key is in it
+func (db *DB) find(key []byte) (*node, bool, error) {
+	n, err := db.pager.node(db.meta.root)
+	for err == nil && !n.leaf {
+		n, err = db.pager.node(n.children[n.childIndex(key)])
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	_, found := n.search(key)
+	return n, found, nil
+}
+
+// Put stores value under key and commits the change to disk
+func (db *DB) Put(key, value []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if err := db.check(); err != nil {
+		return err
+	}
+	if len(key)+len(value)+10 > (db.pager.pageSize-pageHeaderSize-8)/4 {
+		return ErrTooLarge
+	}
+	key, value = bytes.Clone(key), bytes.Clone(value)
+	return db.update(func(tx *tx) error {
+		kids, added, err := tx.put(tx.meta.root, key, value)
+		if err != nil {
+			return err
+		}
+		if added {
+			tx.meta.count++
+		}
+		return tx.setRoot(kids)
+	})
+}
+
+// Delete removes key, commits the change and reports whether key was present
+func (db *DB) Delete(key []byte) (bool, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if err := db.check(); err != nil {
+		return false, err
+	}
+	if _, found, err := db.find(key); err != nil || !found {
+		return false, err
+	}
+	err := db.update(func(tx *tx) error {
+		kids, err := tx.remove(tx.meta.root, key)
+		if err != nil {
+			return err
+		}
+		tx.meta.count--
+		return tx.setRoot(kids)
+	})
+	return err == nil, err
+}
+
+// setRoot makes the rewritten root the root of the tree, growing a new level
+// when the root split and dropping branch levels left with a single child
+func (tx *tx) setRoot(kids []child) error {
+	for len(kids) > 1 {
+		root := &node{}
+		for i, kid := range kids {
+			if i > 0 {
+				root.keys = append(root.keys, kid.key)
+			}
+			root.children = append(root.children, kid.id)
+		}
+		var err error
+		if kids, err = tx.rewrite(0, root); err != nil {
+			return err
+		}
+	}
+	tx.meta.root = kids[0].id
+	for {
+		root, err := tx.pager.node(tx.meta.root)
+		if err != nil {
+			return err
+		}
+		if root.leaf || len(root.children) > 1 {
+			return nil
+		}
+		tx.pager.release(tx.meta.root)
+		tx.meta.root = root.children[0]
+	}
+}
+
+// Len returns the number of keys in the tree
+func (db *DB) Len() int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return int(db.meta.count)
+}
+
+// Range calls fn for every key between lo and hi inclusive, in ascending
+// order, until fn returns false. A nil lo or hi leaves that end unbounded.
+// fn must not call other methods of the DB.
+func (db *DB) Range(lo, hi []byte, fn func(key, value []byte) bool) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if err := db.check(); err != nil {
+		return err
+	}
+	_, err := db.scan(db.meta.root, lo, hi, fn)
+	return err
+}
+
+// scan walks the subtree rooted at id in key order, skipping children that
+// lie outside [lo, hi], and reports whether the walk should continue
+func (db *DB) scan(id pgid, lo, hi []byte, fn func(key, value []byte) bool) (bool, error) {
+	n, err := db.pager.node(id)
+	if err != nil {
+		return false, err
+	}
+	start := 0
+	if lo != nil {
+		if n.leaf {
+			start, _ = n.search(lo)
+		} else {
+			start = n.childIndex(lo)
+		}
+	}
+	if n.leaf {
+		for i := start; i < len(n.keys); i++ {
+			if hi != nil && bytes.Compare(n.keys[i], hi) > 0 {
+				return false, nil
+			}
+			if !fn(bytes.Clone(n.keys[i]), bytes.Clone(n.values[i])) {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+	for i := start; i < len(n.children); i++ {
+		if i > 0 && hi != nil && bytes.Compare(n.keys[i-1], hi) > 0 {
+			return false, nil
+		}
+		if more, err := db.scan(n.children[i], lo, hi, fn); err != nil || !more {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Close closes the underlying file. The DB cannot be used afterwards.
+func (db *DB) Close() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.closed {
+		return ErrClosed
+	}
+	db.closed = true
+	return db.file.Close()
+}
@@ -0,0 +1,428 @@
+package diskbtree
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// memFile is an in-memory File. Writes beyond budget bytes are torn: only
+// the bytes within the budget reach the data and the write fails, which
+// simulates a crash in the middle of an update.
+type memFile struct {
+	data   []byte
+	budget int // bytes that may still be written, -1 for unlimited
+}
+
+var errCrash = errors.New("simulated crash")
+
+func (f *memFile) ReadAt(p []byte, off int64) (int, error) {
+	if off >= int64(len(f.data)) {
+		return 0, io.EOF
+	}
+	n := copy(p, f.data[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (f *memFile) WriteAt(p []byte, off int64) (int, error) {
+	torn := f.budget >= 0 && len(p) > f.budget
+	if torn {
+		p = p[:f.budget]
+	}
+	if end := int(off) + len(p); end > len(f.data) {
+		f.data = append(f.data, make([]byte, end-len(f.data))...)
+	}
+	copy(f.data[off:], p)
+	if f.budget >= 0 {
+		f.budget -= len(p)
+	}
+	if torn {
+		return len(p), errCrash
+	}
+	return len(p), nil
+}
+
+func (f *memFile) Sync() error  { return nil }
+func (f *memFile) Close() error { return nil }
+
+func key(i int) []byte {
+	return []byte(fmt.Sprintf("key-%05d", i))
+}
+
+// contents reads the whole tree through Range, checking that keys come out
+// in ascending order and that Len agrees
+func contents(t *testing.T, db *DB) map[string]string {
+	t.Helper()
+	m := map[string]string{}
+	var prev string
+	err := db.Range(nil, nil, func(k, v []byte) bool {
+		if len(m) > 0 && string(k) <= prev {
+			t.Fatalf("Range returned %q after %q", k, prev)
+		}
+		prev = string(k)
+		m[string(k)] = string(v)
+		return true
+	})
+	if err != nil {
+		t.Fatalf("Range: %v", err)
+	}
+	if db.Len() != len(m) {
+		t.Fatalf("Len() = %d, Range found %d keys", db.Len(), len(m))
+	}
+	return m
+}
+
+// randomOp applies a random Put or Delete to db and, if it succeeds, to ref
+func randomOp(t *testing.T, db *DB, ref map[string]string, rng *rand.Rand) error {
+	t.Helper()
+	k := key(rng.Intn(500))
+	if rng.Intn(3) > 0 {
+		v := fmt.Sprintf("v%d-%s", rng.Intn(1000), make([]byte, rng.Intn(20)))
+		err := db.Put(k, []byte(v))
+		if err == nil {
+			ref[string(k)] = v
+		}
+		return err
+	}
+	_, want := ref[string(k)]
+	found, err := db.Delete(k)
+	if err == nil {
+		if found != want {
+			t.Fatalf("Delete(%s) = %v, want %v", k, found, want)
+		}
+		delete(ref, string(k))
+	}
+	return err
+}
+
+func mustOpen(t *testing.T, path string, opts *Options) *DB {
+	t.Helper()
+	db, err := Open(path, opts)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	return db
+}
+
+func TestPutGetDelete(t *testing.T) {
+	db := mustOpen(t, filepath.Join(t.TempDir(), "tree.db"), nil)
+	defer db.Close()
+
+	if _, err := db.Get([]byte("missing")); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get on empty tree: err = %v, want ErrNotFound", err)
+	}
+	for _, fruit := range []string{"banana", "apple", "cherry"} {
+		if err := db.Put([]byte(fruit), []byte("v-"+fruit)); err != nil {
+			t.Fatalf("Put(%s): %v", fruit, err)
+		}
+	}
+	if err := db.Put([]byte("apple"), []byte("green")); err != nil {
+		t.Fatalf("Put replace: %v", err)
+	}
+	if v, err := db.Get([]byte("apple")); err != nil || string(v) != "green" {
+		t.Fatalf("Get(apple) = %q, %v; want green", v, err)
+	}
+	if db.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", db.Len())
+	}
+	if found, err := db.Delete([]byte("banana")); err != nil || !found {
+		t.Fatalf("Delete(banana) = %v, %v; want true", found, err)
+	}
+	if found, err := db.Delete([]byte("banana")); err != nil || found {
+		t.Fatalf("second Delete(banana) = %v, %v; want false", found, err)
+	}
+	want := map[string]string{"apple": "green", "cherry": "v-cherry"}
+	if got := contents(t, db); !maps.Equal(got, want) {
+		t.Fatalf("contents = %v, want %v", got, want)
+	}
+
+	var keys []string
+	db.Range([]byte("b"), []byte("d"), func(k, _ []byte) bool {
+		keys = append(keys, string(k))
+		return true
+	})
+	if !slices.Equal(keys, []string{"cherry"}) {
+		t.Fatalf("Range(b, d) = %v, want [cherry]", keys)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := Open(filepath.Join(dir, "odd.db"), &Options{PageSize: 1000}); err == nil {
+		t.Fatal("Open with a page size that is not a power of two succeeded")
+	}
+	db := mustOpen(t, filepath.Join(dir, "tree.db"), &Options{PageSize: 256})
+	if err := db.Put(make([]byte, 100), nil); !errors.Is(err, ErrTooLarge) {
+		t.Fatalf("Put of a large key: err = %v, want ErrTooLarge", err)
+	}
+	db.Close()
+	if _, err := db.Get([]byte("a")); !errors.Is(err, ErrClosed) {
+		t.Fatalf("Get after Close: err = %v, want ErrClosed", err)
+	}
+	if err := db.Close(); !errors.Is(err, ErrClosed) {
+		t.Fatalf("second Close: err = %v, want ErrClosed", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "junk.db"), []byte("not a tree"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(filepath.Join(dir, "junk.db"), nil); !errors.Is(err, ErrCorrupt) {
+		t.Fatalf("Open of a junk file: err = %v, want ErrCorrupt", err)
+	}
+}
+
+func TestRandomOperationsAndReopen(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	path := filepath.Join(t.TempDir(), "tree.db")
+	db := mustOpen(t, path, &Options{PageSize: 256, CacheSize: 16})
+	ref := map[string]string{}
+	for i := range 5000 {
+		if err := randomOp(t, db, ref, rng); err != nil {
+			t.Fatalf("operation %d: %v", i, err)
+		}
+		if i%500 == 499 {
+			db.Close()
+			// the page size comes from the file, not the options
+			db = mustOpen(t, path, &Options{CacheSize: 4})
+		}
+		if i%100 == 0 {
+			if got := contents(t, db); !maps.Equal(got, ref) {
+				t.Fatalf("after %d operations the tree differs from the map", i+1)
+			}
+			lo, hi := key(rng.Intn(500)), key(rng.Intn(500))
+			var got, want []string
+			db.Range(lo, hi, func(k, _ []byte) bool {
+				got = append(got, string(k))
+				return true
+			})
+			for k := range ref {
+				if k >= string(lo) && k <= string(hi) {
+					want = append(want, k)
+				}
+			}
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Fatalf("Range(%s, %s) = %v, want %v", lo, hi, got, want)
+			}
+		}
+	}
+	for k := range ref {
+		if _, err := db.Delete([]byte(k)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+	db = mustOpen(t, path, nil)
+	defer db.Close()
+	if got := contents(t, db); len(got) != 0 {
+		t.Fatalf("tree holds %d keys after deleting all of them", len(got))
+	}
+}
+
+// TestTruncatedCommits keeps an image of the file after every commit and
+// cuts it short at offsets between the size before and after the commit, as
+// a crash while the file grows would. Reopening must give either the state
+// before the commit or the state after it.
+func TestTruncatedCommits(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	opts := &Options{PageSize: 256}
+	path := filepath.Join(t.TempDir(), "tree.db")
+	db := mustOpen(t, path, opts)
+	defer db.Close()
+
+	ref := map[string]string{}
+	var images [][]byte
+	var states []map[string]string
+	snapshot := func() {
+		image, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		images = append(images, image)
+		states = append(states, maps.Clone(ref))
+	}
+	snapshot()
+	for range 300 {
+		if err := randomOp(t, db, ref, rng); err != nil {
+			t.Fatal(err)
+		}
+		snapshot()
+	}
+
+	crashPath := filepath.Join(t.TempDir(), "crash.db")
+	windows := 0
+	for i := 1; i < len(images); i++ {
+		before, after := len(images[i-1]), len(images[i])
+		if after <= before {
+			continue
+		}
+		windows++
+		for offset := before; offset < after; offset += 1 + rng.Intn(64) {
+			if err := os.WriteFile(crashPath, images[i][:offset], 0o644); err != nil {
+				t.Fatal(err)
+			}
+			crashed, err := Open(crashPath, opts)
+			if err != nil {
+				t.Fatalf("commit %d truncated at %d of %d bytes: %v", i, offset, after, err)
+			}
+			got := contents(t, crashed)
+			crashed.Close()
+			if !maps.Equal(got, states[i-1]) && !maps.Equal(got, states[i]) {
+				t.Fatalf("commit %d truncated at %d of %d bytes reopened as an uncommitted state", i, offset, after)
+			}
+		}
+	}
+	if windows == 0 {
+		t.Fatal("no commit grew the file")
+	}
+}
+
+// TestTornWrites crashes an update after every possible number of written
+// bytes. The reopened file must hold the state before or after the update,
+// and a DB whose update failed before the commit keeps working.
+func TestTornWrites(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	opts := &Options{PageSize: 256}
+	for trial := range 40 {
+		f := &memFile{budget: -1}
+		db, err := OpenFile(f, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		before := map[string]string{}
+		for range rng.Intn(300) {
+			randomOp(t, db, before, rng)
+		}
+		base := slices.Clone(f.data)
+		seed := rng.Int63()
+
+		// Count the bytes the update writes when nothing goes wrong
+		counter := &memFile{data: slices.Clone(base), budget: 1 << 30}
+		cdb, err := OpenFile(counter, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		after := maps.Clone(before)
+		randomOp(t, cdb, after, rand.New(rand.NewSource(seed)))
+		total := 1<<30 - counter.budget
+
+		for budget := 0; budget <= total; budget += 1 + rng.Intn(40) {
+			cf := &memFile{data: slices.Clone(base), budget: -1}
+			cdb, err := OpenFile(cf, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cf.budget = budget
+			state := maps.Clone(before)
+			opErr := randomOp(t, cdb, state, rand.New(rand.NewSource(seed)))
+
+			reopened, err := OpenFile(&memFile{data: slices.Clone(cf.data), budget: -1}, opts)
+			if err != nil {
+				t.Fatalf("trial %d, crash after %d bytes: reopen: %v", trial, budget, err)
+			}
+			got := contents(t, reopened)
+			if !maps.Equal(got, before) && !maps.Equal(got, after) {
+				t.Fatalf("trial %d, crash after %d bytes reopened as an uncommitted state", trial, budget)
+			}
+			if opErr == nil {
+				continue
+			}
+			cf.budget = -1
+			if err := cdb.Put([]byte("z"), []byte("1")); err != nil {
+				// the crash hit the meta page, so the DB refuses writes
+				continue
+			}
+			got = contents(t, cdb)
+			delete(got, "z")
+			if !maps.Equal(got, before) {
+				t.Fatalf("trial %d, crash after %d bytes: rolled back update left changes", trial, budget)
+			}
+		}
+	}
+}
+
+// TestTornInitialize crashes OpenFile on an empty file after every number of
+// written bytes. No commit has finished, so the file must reopen as an empty
+// DB, whatever page size the reopening options name, and keep working.
+func TestTornInitialize(t *testing.T) {
+	for _, pageSize := range []int{256, 4096} {
+		opts := &Options{PageSize: pageSize}
+		counter := &memFile{budget: 1 << 30}
+		db, err := OpenFile(counter, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		db.Close()
+		total := 1<<30 - counter.budget
+
+		for budget := 0; budget < total; budget++ {
+			f := &memFile{budget: budget}
+			if _, err := OpenFile(f, opts); !errors.Is(err, errCrash) {
+				t.Fatalf("page size %d, crash after %d bytes: OpenFile err = %v, want the crash", pageSize, budget, err)
+			}
+			for _, reopenOpts := range []*Options{opts, {PageSize: 512}} {
+				mf := &memFile{data: slices.Clone(f.data), budget: -1}
+				reopened, err := OpenFile(mf, reopenOpts)
+				if err != nil {
+					t.Fatalf("page size %d, crash after %d bytes: reopen: %v", pageSize, budget, err)
+				}
+				if got := contents(t, reopened); len(got) != 0 {
+					t.Fatalf("page size %d, crash after %d bytes reopened holding %v", pageSize, budget, got)
+				}
+				if err := reopened.Put([]byte("a"), []byte("1")); err != nil {
+					t.Fatalf("page size %d, crash after %d bytes: Put after reopen: %v", pageSize, budget, err)
+				}
+				again, err := OpenFile(mf, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := contents(t, again); !maps.Equal(got, map[string]string{"a": "1"}) {
+					t.Fatalf("page size %d, crash after %d bytes: second reopen holds %v", pageSize, budget, got)
+				}
+			}
+		}
+	}
+}
+
+// TestTornFirstMeta checks that the second meta page is found when the first
+// one is damaged, even if the options name a different page size
+func TestTornFirstMeta(t *testing.T) {
+	for _, pageSize := range []int{512, 8192} {
+		t.Run(fmt.Sprint(pageSize), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "tree.db")
+			db := mustOpen(t, path, &Options{PageSize: pageSize})
+			want := map[string]string{}
+			// an odd number of commits leaves the newest meta page in slot 1
+			for i := range 5 {
+				if err := db.Put(key(i), []byte("v")); err != nil {
+					t.Fatal(err)
+				}
+				want[string(key(i))] = "v"
+			}
+			db.Close()
+
+			f, err := os.OpenFile(path, os.O_RDWR, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := f.WriteAt([]byte("torn"), 0); err != nil {
+				t.Fatal(err)
+			}
+			f.Close()
+
+			db = mustOpen(t, path, nil)
+			defer db.Close()
+			if got := contents(t, db); !maps.Equal(got, want) {
+				t.Fatalf("contents = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package diskbtree
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+)
+
+// Every page starts with an 8-byte header: a CRC-32 checksum of the rest of
+// the page, the page type and the number of entries.
+//
+//	meta:   magic u32 | page size u32 | txid u64 | root u64 | page count u64 | key count u64
+//	leaf:   (key length u16 | value length u16 | key | value) per entry
+//	branch: child u64, then (key length u16 | key | child u64) per key
+//
+// Pages 0 and 1 hold alternating meta pages; all other pages are tree nodes.
+const (
+	pageHeaderSize = 8
+	metaSize       = 48
+	magic          = 0x44425054 // "DBPT"
+
+	// DefaultPageSize is used when Options.PageSize is zero
+	DefaultPageSize = 4096
+	minPageSize     = 256
+	maxPageSize     = 1 << 16
+)
+
+type pageType byte
+
+const (
+	metaPage pageType = iota + 1
+	branchPage
+	leafPage
+)
+
+// pgid is the index of a page in the file
+type pgid uint64
+
+// meta describes one committed version of the tree
+type meta struct {
+	pageSize  uint32
+	txid      uint64
+	root      pgid
+	pageCount uint64 // pages in use, including the two meta pages
+	count     uint64 // keys in the tree
+}
+
+func (m *meta) encode(buf []byte) {
+	buf[4] = byte(metaPage)
+	binary.LittleEndian.PutUint32(buf[8:], magic)
+	binary.LittleEndian.PutUint32(buf[12:], m.pageSize)
+	binary.LittleEndian.PutUint64(buf[16:], m.txid)
+	binary.LittleEndian.PutUint64(buf[24:], uint64(m.root))
+	binary.LittleEndian.PutUint64(buf[32:], m.pageCount)
+	binary.LittleEndian.PutUint64(buf[40:], m.count)
+	binary.LittleEndian.PutUint32(buf, crc32.ChecksumIEEE(buf[4:metaSize]))
+}
+
+func decodeMeta(buf []byte) (meta, error) {
+	if len(buf) < metaSize || binary.LittleEndian.Uint32(buf) != crc32.ChecksumIEEE(buf[4:metaSize]) {
+		return meta{}, fmt.Errorf("%w: bad meta page checksum", ErrCorrupt)
+	}
+	if pageType(buf[4]) != metaPage || binary.LittleEndian.Uint32(buf[8:]) != magic {
+		return meta{}, fmt.Errorf("%w: not a meta page", ErrCorrupt)
+	}
+	m := meta{
+		pageSize:  binary.LittleEndian.Uint32(buf[12:]),
+		txid:      binary.LittleEndian.Uint64(buf[16:]),
+		root:      pgid(binary.LittleEndian.Uint64(buf[24:])),
+		pageCount: binary.LittleEndian.Uint64(buf[32:]),
+		count:     binary.LittleEndian.Uint64(buf[40:]),
+	}
+	if !validPageSize(int(m.pageSize)) || m.root < 2 || uint64(m.root) >= m.pageCount {
+		return meta{}, fmt.Errorf("%w: invalid meta page", ErrCorrupt)
+	}
+	return m, nil
+}
+
+func validPageSize(size int) bool {
+	return size >= minPageSize && size <= maxPageSize && size&(size-1) == 0
+}
+
+// node is the decoded form of a leaf or branch page. In a branch keys[i]
+// separates children[i], whose keys are all smaller, from children[i+1],
+// whose keys are all greater or equal. Nodes may be shared with the page
+// cache, so they are cloned before being modified.
+type node struct {
+	leaf     bool
+	keys     [][]byte
+	values   [][]byte // leaves only
+	children []pgid   // branches only
+}
+
+func (n *node) clone() *node {
+	return &node{
+		leaf:     n.leaf,
+		keys:     append([][]byte(nil), n.keys...),
+		values:   append([][]byte(nil), n.values...),
+		children: append([]pgid(nil), n.children...),
+	}
+}
+
+// search returns the position of key among the node's keys and whether it is there
+func (n *node) search(key []byte) (int, bool) {
+	lo, hi := 0, len(n.keys)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if bytes.Compare(n.keys[mid], key) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(n.keys) && bytes.Equal(n.keys[lo], key)
+}
+
+// childIndex returns the index of the child of a branch whose range covers key
+func (n *node) childIndex(key []byte) int {
+	i, found := n.search(key)
+	if found {
+		i++
+	}
+	return i
+}
+
+// entrySize returns the encoded size of entry i, not counting a branch's first child
+func (n *node) entrySize(i int) int {
+	if n.leaf {
+		return 4 + len(n.keys[i]) + len(n.values[i])
+	}
+	return 2 + len(n.keys[i]) + 8
+}
+
+// size returns the number of bytes the node needs when encoded
+func (n *node) size() int {
+	size := pageHeaderSize
+	if !n.leaf {
+		size += 8
+	}
+	for i := range n.keys {
+		size += n.entrySize(i)
+	}
+	return size
+}
+
+// encode writes the node into a zeroed page-sized buffer and checksums it
+func (n *node) encode(buf []byte) {
+	typ := branchPage
+	if n.leaf {
+		typ = leafPage
+	}
+	buf[4] = byte(typ)
+	binary.LittleEndian.PutUint16(buf[5:], uint16(len(n.keys)))
+	off := pageHeaderSize
+	if n.leaf {
+		for i, key := range n.keys {
+			binary.LittleEndian.PutUint16(buf[off:], uint16(len(key)))
+			binary.LittleEndian.PutUint16(buf[off+2:], uint16(len(n.values[i])))
+			off += 4
+			off += copy(buf[off:], key)
+			off += copy(buf[off:], n.values[i])
+		}
+	} else {
+		binary.LittleEndian.PutUint64(buf[off:], uint64(n.children[0]))
+		off += 8
+		for i, key := range n.keys {
+			binary.LittleEndian.PutUint16(buf[off:], uint16(len(key)))
+			off += 2
+			off += copy(buf[off:], key)
+			binary.LittleEndian.PutUint64(buf[off:], uint64(n.children[i+1]))
+			off += 8
+		}
+	}
+	binary.LittleEndian.PutUint32(buf, crc32.ChecksumIEEE(buf[4:]))
+}
+
+// decodeNode parses a leaf or branch page, copying keys and values out of buf
+func decodeNode(buf []byte) (*node, error) {
+	if binary.LittleEndian.Uint32(buf) != crc32.ChecksumIEEE(buf[4:]) {
+		return nil, fmt.Errorf("%w: bad page checksum", ErrCorrupt)
+	}
+	typ := pageType(buf[4])
+	if typ != leafPage && typ != branchPage {
+		return nil, fmt.Errorf("%w: unexpected page type %d", ErrCorrupt, typ)
+	}
+	count := int(binary.LittleEndian.Uint16(buf[5:]))
+	n := &node{leaf: typ == leafPage, keys: make([][]byte, count)}
+	r := reader{buf: buf, off: pageHeaderSize}
+	if n.leaf {
+		n.values = make([][]byte, count)
+		for i := range count {
+			keyLen, valueLen := int(r.uint16()), int(r.uint16())
+			n.keys[i] = r.bytes(keyLen)
+			n.values[i] = r.bytes(valueLen)
+		}
+	} else {
+		n.children = make([]pgid, count+1)
+		n.children[0] = pgid(r.uint64())
+		for i := range count {
+			n.keys[i] = r.bytes(int(r.uint16()))
+			n.children[i+1] = pgid(r.uint64())
+		}
+	}
+	if r.overflow {
+		return nil, fmt.Errorf("%w: page entries overflow the page", ErrCorrupt)
+	}
+	return n, nil
+}
+
+// reader decodes little-endian fields from a page, recording instead of
+// panicking when a field would run past the end
+type reader struct {
+	buf      []byte
+	off      int
+	overflow bool
+}
+
+func (r *reader) next(n int) []byte {
+	if r.overflow || r.off+n > len(r.buf) {
+		r.overflow = true
+		return make([]byte, n)
+	}
+	b := r.buf[r.off : r.off+n]
+	r.off += n
+	return b
+}
+
+func (r *reader) uint16() uint16 {
+	return binary.LittleEndian.Uint16(r.next(2))
+}
+
+func (r *reader) uint64() uint64 {
+	return binary.LittleEndian.Uint64(r.next(8))
+}
+
+func (r *reader) bytes(n int) []byte {
+	return bytes.Clone(r.next(n))
+}
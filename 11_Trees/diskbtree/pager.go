@@ -0,0 +1,136 @@
+package diskbtree
+
+import (
+	"cmp"
+	"container/list"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// File is the storage a DB lives in. *os.File implements it; tests can
+// supply their own implementation to inject failures.
+type File interface {
+	io.ReaderAt
+	io.WriterAt
+	Sync() error
+	Close() error
+}
+
+// pager reads and writes fixed-size pages, keeps recently used nodes in an
+// LRU cache and hands out page ids from the free list
+type pager struct {
+	file     File
+	pageSize int
+
+	cache    map[pgid]*list.Element
+	lru      *list.List // of *cacheEntry, most recently used at the front
+	capacity int
+
+	free    []pgid // pages no committed tree refers to, sorted descending
+	pending []pgid // pages released by the open transaction
+}
+
+type cacheEntry struct {
+	id   pgid
+	node *node
+}
+
+func newPager(file File, pageSize, cacheSize int) *pager {
+	return &pager{
+		file:     file,
+		pageSize: pageSize,
+		cache:    make(map[pgid]*list.Element),
+		lru:      list.New(),
+		capacity: cacheSize,
+	}
+}
+
+// readPage reads page id into buf, reporting a short read as corruption
+func (p *pager) readPage(id pgid, buf []byte) error {
+	n, err := p.file.ReadAt(buf, int64(id)*int64(p.pageSize))
+	if n == len(buf) {
+		return nil
+	}
+	if err == nil || errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: page %d is truncated", ErrCorrupt, id)
+	}
+	return err
+}
+
+// node returns the decoded node stored in page id
+func (p *pager) node(id pgid) (*node, error) {
+	if elem, ok := p.cache[id]; ok {
+		p.lru.MoveToFront(elem)
+		return elem.Value.(*cacheEntry).node, nil
+	}
+	buf := make([]byte, p.pageSize)
+	if err := p.readPage(id, buf); err != nil {
+		return nil, err
+	}
+	n, err := decodeNode(buf)
+	if err != nil {
+		return nil, fmt.Errorf("page %d: %w", id, err)
+	}
+	p.remember(id, n)
+	return n, nil
+}
+
+// write stores n in page id and caches it
+func (p *pager) write(id pgid, n *node) error {
+	buf := make([]byte, p.pageSize)
+	n.encode(buf)
+	if _, err := p.file.WriteAt(buf, int64(id)*int64(p.pageSize)); err != nil {
+		return err
+	}
+	p.remember(id, n)
+	return nil
+}
+
+func (p *pager) remember(id pgid, n *node) {
+	if elem, ok := p.cache[id]; ok {
+		elem.Value.(*cacheEntry).node = n
+		p.lru.MoveToFront(elem)
+		return
+	}
+	p.cache[id] = p.lru.PushFront(&cacheEntry{id: id, node: n})
+	if p.lru.Len() > p.capacity {
+		oldest := p.lru.Back()
+		p.lru.Remove(oldest)
+		delete(p.cache, oldest.Value.(*cacheEntry).id)
+	}
+}
+
+func (p *pager) forget(id pgid) {
+	if elem, ok := p.cache[id]; ok {
+		p.lru.Remove(elem)
+		delete(p.cache, id)
+	}
+}
+
+// allocate returns a page that no committed tree refers to, growing the
+// file through m.pageCount when the free list is empty
+func (p *pager) allocate(m *meta) pgid {
+	if len(p.free) > 0 {
+		id := p.free[len(p.free)-1]
+		p.free = p.free[:len(p.free)-1]
+		return id
+	}
+	id := pgid(m.pageCount)
+	m.pageCount++
+	return id
+}
+
+// release marks a page as garbage once the open transaction commits. Until
+// then the last committed tree may still refer to it, so it cannot be reused.
+func (p *pager) release(id pgid) {
+	p.pending = append(p.pending, id)
+}
+
+// commit makes the pages released by the transaction available for reuse
+func (p *pager) commit() {
+	p.free = append(p.free, p.pending...)
+	slices.SortFunc(p.free, func(a, b pgid) int { return cmp.Compare(b, a) })
+	p.pending = p.pending[:0]
+}
@@ -0,0 +1,109 @@
+package heap
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type edge struct {
+	to, weight int
+}
+
+type entry struct {
+	dist, node int
+}
+
+func lessEntry(a, b entry) bool {
+	return a.dist < b.dist
+}
+
+// randomGraph builds a connected directed graph with the given number of
+// nodes and roughly degree outgoing edges per node
+func randomGraph(nodes, degree int, rng *rand.Rand) [][]edge {
+	graph := make([][]edge, nodes)
+	for u := 1; u < nodes; u++ {
+		parent := rng.Intn(u)
+		graph[parent] = append(graph[parent], edge{to: u, weight: 1 + rng.Intn(100)})
+	}
+	for u := range graph {
+		for len(graph[u]) < degree {
+			graph[u] = append(graph[u], edge{to: rng.Intn(nodes), weight: 1 + rng.Intn(100)})
+		}
+	}
+	return graph
+}
+
+// dijkstra computes shortest distances from node 0, using decrease-key on
+// the heap instead of inserting duplicate entries
+func dijkstra(graph [][]edge, h MeldableHeap[entry]) []int {
+	dist := make([]int, len(graph))
+	items := make([]Item[entry], len(graph))
+	done := make([]bool, len(graph))
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[0] = 0
+	items[0] = h.Insert(entry{0, 0})
+	for h.Len() > 0 {
+		current, _ := h.ExtractMin()
+		done[current.node] = true
+		for _, e := range graph[current.node] {
+			if done[e.to] {
+				continue
+			}
+			d := current.dist + e.weight
+			switch {
+			case dist[e.to] == -1:
+				dist[e.to] = d
+				items[e.to] = h.Insert(entry{d, e.to})
+			case d < dist[e.to]:
+				dist[e.to] = d
+				h.DecreaseKey(items[e.to], entry{d, e.to})
+			}
+		}
+	}
+	return dist
+}
+
+var dijkstraHeaps = []struct {
+	name string
+	make func() MeldableHeap[entry]
+}{
+	{"binary", func() MeldableHeap[entry] { return NewDary(2, lessEntry) }},
+	{"4-ary", func() MeldableHeap[entry] { return NewDary(4, lessEntry) }},
+	{"pairing", func() MeldableHeap[entry] { return NewPairing(lessEntry) }},
+	{"binomial", func() MeldableHeap[entry] { return NewBinomial(lessEntry) }},
+	{"Fibonacci", func() MeldableHeap[entry] { return NewFibonacci(lessEntry) }},
+}
+
+func TestDijkstraAgreesAcrossHeaps(t *testing.T) {
+	graph := randomGraph(2000, 6, rand.New(rand.NewSource(1)))
+	reference := dijkstra(graph, dijkstraHeaps[0].make())
+	for _, hp := range dijkstraHeaps[1:] {
+		if got := dijkstra(graph, hp.make()); !slices.Equal(got, reference) {
+			t.Errorf("%s heap computed different distances", hp.name)
+		}
+	}
+}
+
+// BenchmarkDijkstra runs a decrease-key heavy shortest-path workload on each
+// heap; sparse graphs favour cheap ExtractMin, dense ones cheap DecreaseKey
+func BenchmarkDijkstra(b *testing.B) {
+	for _, shape := range []struct {
+		name          string
+		nodes, degree int
+	}{
+		{"sparse", 100000, 4},
+		{"dense", 20000, 64},
+	} {
+		graph := randomGraph(shape.nodes, shape.degree, rand.New(rand.NewSource(1)))
+		for _, hp := range dijkstraHeaps {
+			b.Run(shape.name+"/"+hp.name, func(b *testing.B) {
+				for range b.N {
+					dijkstra(graph, hp.make())
+				}
+			})
+		}
+	}
+}
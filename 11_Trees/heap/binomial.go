@@ -0,0 +1,195 @@
+package heap
+
+// BinomialHeap is a forest of binomial trees with at most one tree of each
+// degree, kept in a root list ordered by degree. Melding two heaps works like
+// adding two binary numbers: trees of equal degree are linked as a carry.
+type BinomialHeap[T any] struct {
+	head  *binomialNode[T]
+	size  int
+	less  func(a, b T) bool
+	owner *owner
+}
+
+type binomialNode[T any] struct {
+	item    *binomialItem[T]
+	parent  *binomialNode[T]
+	child   *binomialNode[T] // child with the highest degree
+	sibling *binomialNode[T]
+	degree  int
+}
+
+// binomialItem is the handle given to callers. DecreaseKey moves values up
+// the tree by swapping items between nodes, so the handle points at its
+// current node instead of being the node itself.
+type binomialItem[T any] struct {
+	value T
+	node  *binomialNode[T]
+	owner *owner
+}
+
+// Value returns the element the handle refers to
+func (it *binomialItem[T]) Value() T {
+	return it.value
+}
+
+// NewBinomial creates an empty binomial heap ordered by less
+func NewBinomial[T any](less func(a, b T) bool) *BinomialHeap[T] {
+	return &BinomialHeap[T]{less: less, owner: &owner{}}
+}
+
+// Insert adds a value and returns a handle to it
+func (h *BinomialHeap[T]) Insert(value T) Item[T] {
+	item := &binomialItem[T]{value: value, owner: h.owner}
+	item.node = &binomialNode[T]{item: item}
+	h.head = h.union(h.head, item.node)
+	h.size++
+	return item
+}
+
+// Min returns the minimum value without removing it
+func (h *BinomialHeap[T]) Min() (T, error) {
+	_, root := h.minRoot()
+	if root == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return root.item.value, nil
+}
+
+// ExtractMin removes and returns the minimum value
+func (h *BinomialHeap[T]) ExtractMin() (T, error) {
+	prev, root := h.minRoot()
+	if root == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	if prev == nil {
+		h.head = root.sibling
+	} else {
+		prev.sibling = root.sibling
+	}
+	// The children are ordered by decreasing degree; reverse them into a root list
+	var children *binomialNode[T]
+	for child := root.child; child != nil; {
+		next := child.sibling
+		child.parent = nil
+		child.sibling = children
+		children = child
+		child = next
+	}
+	h.head = h.union(h.head, children)
+	h.size--
+	root.item.node = nil
+	return root.item.value, nil
+}
+
+// DecreaseKey replaces the value behind item with one that orders no later
+// and bubbles it up towards the root of its tree
+func (h *BinomialHeap[T]) DecreaseKey(item Item[T], value T) error {
+	it, ok := item.(*binomialItem[T])
+	if !ok || it.node == nil || it.owner.find() != h.owner {
+		return ErrStaleHandle
+	}
+	if h.less(it.value, value) {
+		return ErrKeyIncreased
+	}
+	it.value = value
+	node := it.node
+	for node.parent != nil && h.less(node.item.value, node.parent.item.value) {
+		parent := node.parent
+		node.item, parent.item = parent.item, node.item
+		node.item.node = node
+		parent.item.node = parent
+		node = parent
+	}
+	return nil
+}
+
+// Meld moves every element of other into this heap in O(log n)
+func (h *BinomialHeap[T]) Meld(other MeldableHeap[T]) error {
+	o, ok := other.(*BinomialHeap[T])
+	if !ok {
+		return ErrIncompatibleHeap
+	}
+	if o == h {
+		return nil
+	}
+	h.head = h.union(h.head, o.head)
+	h.size += o.size
+	h.owner.absorb(o.owner)
+	o.head, o.size, o.owner = nil, 0, &owner{}
+	return nil
+}
+
+// Len returns the number of elements in the heap
+func (h *BinomialHeap[T]) Len() int {
+	return h.size
+}
+
+// minRoot returns the root holding the minimum and the root before it
+func (h *BinomialHeap[T]) minRoot() (prev, root *binomialNode[T]) {
+	var before *binomialNode[T]
+	for current := h.head; current != nil; before, current = current, current.sibling {
+		if root == nil || h.less(current.item.value, root.item.value) {
+			prev, root = before, current
+		}
+	}
+	return prev, root
+}
+
+// union merges two root lists by degree and links trees of equal degree
+func (h *BinomialHeap[T]) union(a, b *binomialNode[T]) *binomialNode[T] {
+	head := mergeRootLists(a, b)
+	if head == nil {
+		return nil
+	}
+	var prev *binomialNode[T]
+	current := head
+	next := current.sibling
+	for next != nil {
+		if current.degree != next.degree || (next.sibling != nil && next.sibling.degree == current.degree) {
+			prev, current = current, next
+		} else if !h.less(next.item.value, current.item.value) {
+			current.sibling = next.sibling
+			linkBinomialTrees(next, current)
+		} else {
+			if prev == nil {
+				head = next
+			} else {
+				prev.sibling = next
+			}
+			linkBinomialTrees(current, next)
+			current = next
+		}
+		next = current.sibling
+	}
+	return head
+}
+
+// mergeRootLists interleaves two root lists in order of increasing degree
+func mergeRootLists[T any](a, b *binomialNode[T]) *binomialNode[T] {
+	dummy := &binomialNode[T]{}
+	tail := dummy
+	for a != nil && b != nil {
+		if a.degree <= b.degree {
+			tail.sibling, a = a, a.sibling
+		} else {
+			tail.sibling, b = b, b.sibling
+		}
+		tail = tail.sibling
+	}
+	if a != nil {
+		tail.sibling = a
+	} else {
+		tail.sibling = b
+	}
+	return dummy.sibling
+}
+
+// linkBinomialTrees makes child, a tree of the same degree, the first child of parent
+func linkBinomialTrees[T any](child, parent *binomialNode[T]) {
+	child.parent = parent
+	child.sibling = parent.child
+	parent.child = child
+	parent.degree++
+}
@@ -0,0 +1,125 @@
+package heap
+
+// DaryHeap is an implicit heap in which every node has up to d children.
+// A wider fan-out makes the tree shallower, so Insert and DecreaseKey touch
+// fewer levels at the cost of more comparisons per level in ExtractMin.
+type DaryHeap[T any] struct {
+	data []*Handle[T]
+	d    int
+	less func(a, b T) bool
+}
+
+// NewDary creates an empty d-ary heap ordered by less; d must be at least 2
+func NewDary[T any](d int, less func(a, b T) bool) *DaryHeap[T] {
+	if d < 2 {
+		panic("heap: d-ary heap needs d >= 2")
+	}
+	return &DaryHeap[T]{d: d, less: less}
+}
+
+// Insert adds a value and returns a handle to it
+func (h *DaryHeap[T]) Insert(value T) Item[T] {
+	handle := &Handle[T]{value: value, index: len(h.data)}
+	h.data = append(h.data, handle)
+	h.up(handle.index)
+	return handle
+}
+
+// Min returns the minimum value without removing it
+func (h *DaryHeap[T]) Min() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return h.data[0].value, nil
+}
+
+// ExtractMin removes and returns the minimum value
+func (h *DaryHeap[T]) ExtractMin() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	root := h.data[0]
+	n := len(h.data) - 1
+	h.swap(0, n)
+	h.data[n] = nil
+	h.data = h.data[:n]
+	h.down(0)
+	root.index = -1
+	return root.value, nil
+}
+
+// DecreaseKey replaces the value behind item with one that orders no later
+func (h *DaryHeap[T]) DecreaseKey(item Item[T], value T) error {
+	handle, ok := item.(*Handle[T])
+	if !ok || handle.index < 0 || handle.index >= len(h.data) || h.data[handle.index] != handle {
+		return ErrStaleHandle
+	}
+	if h.less(handle.value, value) {
+		return ErrKeyIncreased
+	}
+	handle.value = value
+	h.up(handle.index)
+	return nil
+}
+
+// Meld moves every element of other into this heap and rebuilds it in O(n + m)
+func (h *DaryHeap[T]) Meld(other MeldableHeap[T]) error {
+	o, ok := other.(*DaryHeap[T])
+	if !ok {
+		return ErrIncompatibleHeap
+	}
+	if o == h {
+		return nil
+	}
+	for _, handle := range o.data {
+		handle.index = len(h.data)
+		h.data = append(h.data, handle)
+	}
+	o.data = nil
+	for i := (len(h.data) - 2) / h.d; i >= 0; i-- {
+		h.down(i)
+	}
+	return nil
+}
+
+// Len returns the number of elements in the heap
+func (h *DaryHeap[T]) Len() int {
+	return len(h.data)
+}
+
+func (h *DaryHeap[T]) swap(i, j int) {
+	h.data[i], h.data[j] = h.data[j], h.data[i]
+	h.data[i].index = i
+	h.data[j].index = j
+}
+
+func (h *DaryHeap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / h.d
+		if !h.less(h.data[i].value, h.data[parent].value) {
+			break
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+func (h *DaryHeap[T]) down(i int) {
+	n := len(h.data)
+	for {
+		smallest := i
+		first := h.d*i + 1
+		for child := first; child < first+h.d && child < n; child++ {
+			if h.less(h.data[child].value, h.data[smallest].value) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		h.swap(i, smallest)
+		i = smallest
+	}
+}
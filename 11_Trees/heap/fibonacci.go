@@ -0,0 +1,227 @@
+package heap
+
+// FibonacciHeap keeps a lazy circular list of heap-ordered trees. Insert and
+// Meld only splice lists together, and DecreaseKey cuts a node out to the root
+// list, marking parents so no tree loses more than one child before it is cut
+// itself. All the tidying up happens in ExtractMin, which links trees of equal
+// degree until every root has a distinct degree.
+type FibonacciHeap[T any] struct {
+	min   *fibNode[T]
+	size  int
+	less  func(a, b T) bool
+	owner *owner
+}
+
+type fibNode[T any] struct {
+	value  T
+	parent *fibNode[T]
+	child  *fibNode[T]
+	left   *fibNode[T]
+	right  *fibNode[T]
+	owner  *owner
+	degree int
+	mark   bool
+	in     bool
+}
+
+// Value returns the element the handle refers to
+func (n *fibNode[T]) Value() T {
+	return n.value
+}
+
+// NewFibonacci creates an empty Fibonacci heap ordered by less
+func NewFibonacci[T any](less func(a, b T) bool) *FibonacciHeap[T] {
+	return &FibonacciHeap[T]{less: less, owner: &owner{}}
+}
+
+// Insert adds a value and returns a handle to it
+func (h *FibonacciHeap[T]) Insert(value T) Item[T] {
+	node := &fibNode[T]{value: value, owner: h.owner, in: true}
+	node.left, node.right = node, node
+	h.addRoot(node)
+	h.size++
+	return node
+}
+
+// Min returns the minimum value without removing it
+func (h *FibonacciHeap[T]) Min() (T, error) {
+	if h.min == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return h.min.value, nil
+}
+
+// ExtractMin removes and returns the minimum value
+func (h *FibonacciHeap[T]) ExtractMin() (T, error) {
+	z := h.min
+	if z == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	if z.child != nil {
+		child := z.child
+		for {
+			child.parent = nil
+			child = child.right
+			if child == z.child {
+				break
+			}
+		}
+		spliceFibLists(z, z.child)
+		z.child = nil
+	}
+	if z.right == z {
+		h.min = nil
+	} else {
+		z.left.right = z.right
+		z.right.left = z.left
+		h.min = z.right
+		h.consolidate()
+	}
+	z.left, z.right = z, z
+	z.in = false
+	h.size--
+	return z.value, nil
+}
+
+// DecreaseKey replaces the value behind item with one that orders no later.
+// If that breaks heap order the node is cut to the root list, followed by a
+// cascade of cuts up through already-marked ancestors.
+func (h *FibonacciHeap[T]) DecreaseKey(item Item[T], value T) error {
+	x, ok := item.(*fibNode[T])
+	if !ok || !x.in || x.owner.find() != h.owner {
+		return ErrStaleHandle
+	}
+	if h.less(x.value, value) {
+		return ErrKeyIncreased
+	}
+	x.value = value
+	if parent := x.parent; parent != nil && h.less(x.value, parent.value) {
+		h.cut(x, parent)
+		for y := parent; y.parent != nil; {
+			if !y.mark {
+				y.mark = true
+				break
+			}
+			grandparent := y.parent
+			h.cut(y, grandparent)
+			y = grandparent
+		}
+	}
+	if h.less(x.value, h.min.value) {
+		h.min = x
+	}
+	return nil
+}
+
+// Meld moves every element of other into this heap in O(1)
+func (h *FibonacciHeap[T]) Meld(other MeldableHeap[T]) error {
+	o, ok := other.(*FibonacciHeap[T])
+	if !ok {
+		return ErrIncompatibleHeap
+	}
+	if o == h || o.min == nil {
+		return nil
+	}
+	h.addRoot(o.min)
+	h.size += o.size
+	h.owner.absorb(o.owner)
+	o.min, o.size, o.owner = nil, 0, &owner{}
+	return nil
+}
+
+// Len returns the number of elements in the heap
+func (h *FibonacciHeap[T]) Len() int {
+	return h.size
+}
+
+// addRoot splices a circular list of roots into the root list and updates min
+func (h *FibonacciHeap[T]) addRoot(list *fibNode[T]) {
+	if h.min == nil {
+		h.min = list
+		return
+	}
+	spliceFibLists(h.min, list)
+	if h.less(list.value, h.min.value) {
+		h.min = list
+	}
+}
+
+// consolidate links roots of equal degree until all degrees are distinct
+func (h *FibonacciHeap[T]) consolidate() {
+	var roots []*fibNode[T]
+	for w := h.min; ; {
+		roots = append(roots, w)
+		w = w.right
+		if w == h.min {
+			break
+		}
+	}
+	var byDegree []*fibNode[T]
+	for _, x := range roots {
+		d := x.degree
+		for d < len(byDegree) && byDegree[d] != nil {
+			y := byDegree[d]
+			if h.less(y.value, x.value) {
+				x, y = y, x
+			}
+			h.link(y, x)
+			byDegree[d] = nil
+			d++
+		}
+		for d >= len(byDegree) {
+			byDegree = append(byDegree, nil)
+		}
+		byDegree[d] = x
+	}
+	h.min = nil
+	for _, root := range byDegree {
+		if root != nil {
+			root.left, root.right = root, root
+			h.addRoot(root)
+		}
+	}
+}
+
+// link removes root y from the root list and makes it a child of root x
+func (h *FibonacciHeap[T]) link(y, x *fibNode[T]) {
+	y.left.right = y.right
+	y.right.left = y.left
+	y.left, y.right = y, y
+	y.parent = x
+	y.mark = false
+	if x.child == nil {
+		x.child = y
+	} else {
+		spliceFibLists(x.child, y)
+	}
+	x.degree++
+}
+
+// cut moves x from the child list of parent to the root list
+func (h *FibonacciHeap[T]) cut(x, parent *fibNode[T]) {
+	if x.right == x {
+		parent.child = nil
+	} else {
+		x.left.right = x.right
+		x.right.left = x.left
+		if parent.child == x {
+			parent.child = x.right
+		}
+	}
+	parent.degree--
+	x.left, x.right = x, x
+	x.parent = nil
+	x.mark = false
+	spliceFibLists(h.min, x)
+}
+
+// spliceFibLists joins two circular doubly linked lists into one
+func spliceFibLists[T any](a, b *fibNode[T]) {
+	aRight, bLeft := a.right, b.left
+	a.right = b
+	b.left = a
+	aRight.left = bLeft
+	bLeft.right = aRight
+}
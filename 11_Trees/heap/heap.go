@@ -0,0 +1,160 @@
+package heap
+
+import "errors"
+
+var (
+	// ErrEmpty is returned when reading from an empty heap
+	ErrEmpty = errors.New("heap: empty")
+	// ErrOutOfRange is returned for a position outside [0, Len())
+	ErrOutOfRange = errors.New("heap: index out of range")
+)
+
+// Heap is a binary heap stored in a slice, ordered by a user-supplied less
+// function: the element for which less reports true against every other
+// element sits at the root. Pass a "greater" function to get a max-heap.
+type Heap[T any] struct {
+	data []T
+	less func(a, b T) bool
+}
+
+// New creates an empty heap ordered by less
+func New[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// Heapify builds a heap from data in O(n) time by sifting down every internal
+// node, starting from the last one. The heap takes ownership of data.
+func Heapify[T any](data []T, less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{data: data, less: less}
+	for i := len(data)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return h
+}
+
+// Push adds an element to the heap
+func (h *Heap[T]) Push(value T) {
+	h.data = append(h.data, value)
+	h.up(len(h.data) - 1)
+}
+
+// Pop removes and returns the root element
+func (h *Heap[T]) Pop() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return h.removeAt(0), nil
+}
+
+// Peek returns the root element without removing it
+func (h *Heap[T]) Peek() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return h.data[0], nil
+}
+
+// Fix restores the heap order after the element at position i has been
+// changed through Set
+func (h *Heap[T]) Fix(i int) error {
+	if i < 0 || i >= len(h.data) {
+		return ErrOutOfRange
+	}
+	if !h.down(i) {
+		h.up(i)
+	}
+	return nil
+}
+
+// Remove removes and returns the element at position i
+func (h *Heap[T]) Remove(i int) (T, error) {
+	if i < 0 || i >= len(h.data) {
+		var zero T
+		return zero, ErrOutOfRange
+	}
+	return h.removeAt(i), nil
+}
+
+// At returns the element at position i in the underlying array
+func (h *Heap[T]) At(i int) (T, error) {
+	if i < 0 || i >= len(h.data) {
+		var zero T
+		return zero, ErrOutOfRange
+	}
+	return h.data[i], nil
+}
+
+// Set replaces the element at position i without restoring the heap order;
+// call Fix(i) afterwards
+func (h *Heap[T]) Set(i int, value T) error {
+	if i < 0 || i >= len(h.data) {
+		return ErrOutOfRange
+	}
+	h.data[i] = value
+	return nil
+}
+
+// Len returns the number of elements in the heap
+func (h *Heap[T]) Len() int {
+	return len(h.data)
+}
+
+// IsEmpty reports whether the heap has no elements
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.data) == 0
+}
+
+func (h *Heap[T]) removeAt(i int) T {
+	n := len(h.data) - 1
+	value := h.data[i]
+	if i != n {
+		h.data[i] = h.data[n]
+	}
+	var zero T
+	h.data[n] = zero
+	h.data = h.data[:n]
+	if i != n {
+		if !h.down(i) {
+			h.up(i)
+		}
+	}
+	return value
+}
+
+// up moves the element at position i towards the root until its parent is
+// not greater than it
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.data[i], h.data[parent]) {
+			break
+		}
+		h.data[i], h.data[parent] = h.data[parent], h.data[i]
+		i = parent
+	}
+}
+
+// down moves the element at position i towards the leaves until neither
+// child is smaller than it, and reports whether it moved
+func (h *Heap[T]) down(i int) bool {
+	start := i
+	n := len(h.data)
+	for {
+		smallest := i
+		left := 2*i + 1
+		right := 2*i + 2
+		if left < n && h.less(h.data[left], h.data[smallest]) {
+			smallest = left
+		}
+		if right < n && h.less(h.data[right], h.data[smallest]) {
+			smallest = right
+		}
+		if smallest == i {
+			return i > start
+		}
+		h.data[i], h.data[smallest] = h.data[smallest], h.data[i]
+		i = smallest
+	}
+}
@@ -0,0 +1,197 @@
+package heap
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// checkOrder fails unless no element of h orders before its parent
+func checkOrder(t *testing.T, h *Heap[int]) {
+	t.Helper()
+	for i := 1; i < len(h.data); i++ {
+		if parent := (i - 1) / 2; h.less(h.data[i], h.data[parent]) {
+			t.Fatalf("element %d at %d orders before its parent %d", h.data[i], i, h.data[parent])
+		}
+	}
+}
+
+// TestHeapAgainstOracle mixes Push, Pop, Set with Fix and Remove at random
+// positions and compares every result with a plain multiset of the values
+func TestHeapAgainstOracle(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	h := New(lessInt)
+	var model []int
+	dropOne := func(value int) {
+		i := slices.Index(model, value)
+		if i < 0 {
+			t.Fatalf("heap returned %d, which the oracle does not hold", value)
+		}
+		model = slices.Delete(model, i, i+1)
+	}
+	for step := range 10000 {
+		switch op := rng.Intn(5); {
+		case op < 2 || len(model) == 0:
+			v := rng.Intn(100)
+			h.Push(v)
+			model = append(model, v)
+		case op == 2:
+			got, err := h.Pop()
+			if want := slices.Min(model); err != nil || got != want {
+				t.Fatalf("step %d: Pop() = %d, %v; want %d", step, got, err, want)
+			}
+			dropOne(got)
+		case op == 3:
+			i := rng.Intn(len(model))
+			old, _ := h.At(i)
+			v := rng.Intn(100)
+			if err := h.Set(i, v); err != nil {
+				t.Fatalf("step %d: Set(%d): %v", step, i, err)
+			}
+			if err := h.Fix(i); err != nil {
+				t.Fatalf("step %d: Fix(%d): %v", step, i, err)
+			}
+			dropOne(old)
+			model = append(model, v)
+		default:
+			i := rng.Intn(len(model))
+			want, _ := h.At(i)
+			if got, err := h.Remove(i); err != nil || got != want {
+				t.Fatalf("step %d: Remove(%d) = %d, %v; want %d", step, i, got, err, want)
+			}
+			dropOne(want)
+		}
+		checkOrder(t, h)
+		if h.Len() != len(model) {
+			t.Fatalf("step %d: Len() = %d, want %d", step, h.Len(), len(model))
+		}
+		if len(model) > 0 {
+			if got, _ := h.Peek(); got != slices.Min(model) {
+				t.Fatalf("step %d: Peek() = %d, want %d", step, got, slices.Min(model))
+			}
+		}
+	}
+}
+
+func TestHeapifyPopsInOrder(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 10, 257} {
+		data := make([]int, n)
+		for i := range data {
+			data[i] = rng.Intn(20)
+		}
+		want := slices.Clone(data)
+		slices.Sort(want)
+		slices.Reverse(want)
+
+		// a greater function turns the heap into a max-heap
+		h := Heapify(data, func(a, b int) bool { return a > b })
+		for i := 1; i < n; i++ {
+			if data[i] > data[(i-1)/2] {
+				t.Fatalf("Heapify of %d elements left %d below %d", n, data[i], data[(i-1)/2])
+			}
+		}
+		var got []int
+		for !h.IsEmpty() {
+			v, _ := h.Pop()
+			got = append(got, v)
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("popping a heapified slice gave %v, want %v", got, want)
+		}
+	}
+}
+
+func TestHeapErrors(t *testing.T) {
+	h := New(lessInt)
+	if _, err := h.Pop(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Pop on empty heap: err = %v, want ErrEmpty", err)
+	}
+	if _, err := h.Peek(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Peek on empty heap: err = %v, want ErrEmpty", err)
+	}
+	h.Push(1)
+	for _, i := range []int{-1, 1} {
+		if err := h.Fix(i); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("Fix(%d): err = %v, want ErrOutOfRange", i, err)
+		}
+		if _, err := h.Remove(i); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("Remove(%d): err = %v, want ErrOutOfRange", i, err)
+		}
+		if _, err := h.At(i); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("At(%d): err = %v, want ErrOutOfRange", i, err)
+		}
+		if err := h.Set(i, 0); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("Set(%d): err = %v, want ErrOutOfRange", i, err)
+		}
+	}
+}
+
+func TestIndexedHeapHandles(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	h := NewIndexed(lessInt)
+	var live []*Handle[int]
+	for step := range 5000 {
+		switch op := rng.Intn(5); {
+		case op < 2 || len(live) == 0:
+			live = append(live, h.Push(rng.Intn(1000)))
+		case op == 2:
+			i := rng.Intn(len(live))
+			handle := live[i]
+			lower := handle.Value() - rng.Intn(50)
+			if err := h.DecreaseKey(handle, lower); err != nil {
+				t.Fatalf("step %d: DecreaseKey: %v", step, err)
+			}
+			if err := h.DecreaseKey(handle, lower+1); !errors.Is(err, ErrKeyIncreased) {
+				t.Fatalf("step %d: DecreaseKey to a larger value: err = %v, want ErrKeyIncreased", step, err)
+			}
+		case op == 3:
+			i := rng.Intn(len(live))
+			if err := h.Update(live[i], rng.Intn(1000)); err != nil {
+				t.Fatalf("step %d: Update: %v", step, err)
+			}
+		default:
+			// remove either the minimum or an arbitrary handle
+			var removed *Handle[int]
+			if rng.Intn(2) == 0 {
+				smallest := live[0]
+				for _, handle := range live[1:] {
+					if handle.Value() < smallest.Value() {
+						smallest = handle
+					}
+				}
+				got, err := h.Pop()
+				if err != nil || got != smallest.Value() {
+					t.Fatalf("step %d: Pop() = %d, %v; want %d", step, got, err, smallest.Value())
+				}
+				// with duplicates Pop may take a different handle holding the minimum
+				for _, handle := range live {
+					if !h.Contains(handle) {
+						removed = handle
+					}
+				}
+			} else {
+				removed = live[rng.Intn(len(live))]
+				if err := h.Remove(removed); err != nil {
+					t.Fatalf("step %d: Remove: %v", step, err)
+				}
+			}
+			live = slices.DeleteFunc(live, func(handle *Handle[int]) bool { return handle == removed })
+			if h.Contains(removed) {
+				t.Fatalf("step %d: removed handle is still in the heap", step)
+			}
+			if err := h.Update(removed, 0); !errors.Is(err, ErrStaleHandle) {
+				t.Fatalf("step %d: Update of a removed handle: err = %v, want ErrStaleHandle", step, err)
+			}
+		}
+		if h.Len() != len(live) {
+			t.Fatalf("step %d: Len() = %d, want %d", step, h.Len(), len(live))
+		}
+		for _, handle := range live {
+			if !h.Contains(handle) {
+				t.Fatalf("step %d: live handle %d is missing", step, handle.Value())
+			}
+		}
+	}
+}
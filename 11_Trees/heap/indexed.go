@@ -0,0 +1,166 @@
+package heap
+
+import "errors"
+
+var (
+	// ErrStaleHandle is returned for a handle whose element has already left
+	// the heap or that belongs to a different heap
+	ErrStaleHandle = errors.New("heap: handle is not in the heap")
+	// ErrKeyIncreased is returned by DecreaseKey when the new value orders after the old one
+	ErrKeyIncreased = errors.New("heap: new key is greater than current key")
+)
+
+// Handle refers to an element of an IndexedHeap for as long as it stays in the heap
+type Handle[T any] struct {
+	value T
+	index int
+}
+
+// Value returns the element the handle refers to
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// IndexedHeap is a binary heap that hands out a Handle for every element, so
+// an element can be re-prioritised or removed without searching for it. This
+// is the decrease-key operation used by Dijkstra's and Prim's algorithms.
+type IndexedHeap[T any] struct {
+	data []*Handle[T]
+	less func(a, b T) bool
+}
+
+// NewIndexed creates an empty indexed heap ordered by less
+func NewIndexed[T any](less func(a, b T) bool) *IndexedHeap[T] {
+	return &IndexedHeap[T]{less: less}
+}
+
+// Push adds an element to the heap and returns its handle
+func (h *IndexedHeap[T]) Push(value T) *Handle[T] {
+	handle := &Handle[T]{value: value, index: len(h.data)}
+	h.data = append(h.data, handle)
+	h.up(handle.index)
+	return handle
+}
+
+// Pop removes and returns the root element
+func (h *IndexedHeap[T]) Pop() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return h.removeAt(0).value, nil
+}
+
+// Peek returns the root element without removing it
+func (h *IndexedHeap[T]) Peek() (T, error) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return h.data[0].value, nil
+}
+
+// DecreaseKey replaces the element behind handle with a value that orders
+// no later than the current one and moves it towards the root
+func (h *IndexedHeap[T]) DecreaseKey(handle *Handle[T], value T) error {
+	if !h.Contains(handle) {
+		return ErrStaleHandle
+	}
+	if h.less(handle.value, value) {
+		return ErrKeyIncreased
+	}
+	handle.value = value
+	h.up(handle.index)
+	return nil
+}
+
+// Update replaces the element behind handle with any value and restores the heap order
+func (h *IndexedHeap[T]) Update(handle *Handle[T], value T) error {
+	if !h.Contains(handle) {
+		return ErrStaleHandle
+	}
+	handle.value = value
+	if !h.down(handle.index) {
+		h.up(handle.index)
+	}
+	return nil
+}
+
+// Remove removes the element behind handle from the heap
+func (h *IndexedHeap[T]) Remove(handle *Handle[T]) error {
+	if !h.Contains(handle) {
+		return ErrStaleHandle
+	}
+	h.removeAt(handle.index)
+	return nil
+}
+
+// Contains reports whether handle still refers to an element of this heap
+func (h *IndexedHeap[T]) Contains(handle *Handle[T]) bool {
+	return handle != nil && handle.index >= 0 && handle.index < len(h.data) && h.data[handle.index] == handle
+}
+
+// Len returns the number of elements in the heap
+func (h *IndexedHeap[T]) Len() int {
+	return len(h.data)
+}
+
+// IsEmpty reports whether the heap has no elements
+func (h *IndexedHeap[T]) IsEmpty() bool {
+	return len(h.data) == 0
+}
+
+func (h *IndexedHeap[T]) removeAt(i int) *Handle[T] {
+	n := len(h.data) - 1
+	removed := h.data[i]
+	if i != n {
+		h.swap(i, n)
+	}
+	h.data[n] = nil
+	h.data = h.data[:n]
+	if i != n {
+		if !h.down(i) {
+			h.up(i)
+		}
+	}
+	removed.index = -1
+	return removed
+}
+
+func (h *IndexedHeap[T]) swap(i, j int) {
+	h.data[i], h.data[j] = h.data[j], h.data[i]
+	h.data[i].index = i
+	h.data[j].index = j
+}
+
+func (h *IndexedHeap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.data[i].value, h.data[parent].value) {
+			break
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+func (h *IndexedHeap[T]) down(i int) bool {
+	start := i
+	n := len(h.data)
+	for {
+		smallest := i
+		left := 2*i + 1
+		right := 2*i + 2
+		if left < n && h.less(h.data[left].value, h.data[smallest].value) {
+			smallest = left
+		}
+		if right < n && h.less(h.data[right].value, h.data[smallest].value) {
+			smallest = right
+		}
+		if smallest == i {
+			return i > start
+		}
+		h.swap(i, smallest)
+		i = smallest
+	}
+}
@@ -0,0 +1,74 @@
+package heap
+
+import "errors"
+
+// ErrIncompatibleHeap is returned by Meld when the two heaps are of different kinds
+var ErrIncompatibleHeap = errors.New("heap: cannot meld heaps of different kinds")
+
+// Item is a handle to an element inside a MeldableHeap. It stays valid while
+// the element is in the heap, including after the heap is melded into another.
+type Item[T any] interface {
+	Value() T
+}
+
+// MeldableHeap is the interface shared by the d-ary, pairing, binomial and
+// Fibonacci heaps. "Min" is the element that orders first under the heap's
+// less function.
+//
+//	Operation    d-ary        pairing          binomial    Fibonacci
+//	Insert       O(log_d n)   O(1)             O(1)*       O(1)
+//	ExtractMin   O(d log_d n) O(log n)*        O(log n)    O(log n)*
+//	DecreaseKey  O(log_d n)   o(log n)*        O(log n)    O(1)*
+//	Meld         O(n + m)     O(1)             O(log n)    O(1)
+//
+// (* amortized)
+type MeldableHeap[T any] interface {
+	// Insert adds a value and returns a handle to it
+	Insert(value T) Item[T]
+	// Min returns the minimum value without removing it
+	Min() (T, error)
+	// ExtractMin removes and returns the minimum value
+	ExtractMin() (T, error)
+	// DecreaseKey replaces the value behind item with one that orders no later
+	DecreaseKey(item Item[T], value T) error
+	// Meld moves every element of other into this heap, leaving other empty
+	Meld(other MeldableHeap[T]) error
+	// Len returns the number of elements in the heap
+	Len() int
+}
+
+// owner identifies the heap an element belongs to, so DecreaseKey can reject
+// handles from other heaps. Relabelling every element on Meld would cost
+// O(m), so the absorbed heap's owner is instead linked to the absorbing
+// heap's owner and lookups follow the links, as in a union-find forest.
+type owner struct {
+	parent *owner
+}
+
+// find returns the owner at the end of the links, shortening the path for
+// later lookups
+func (o *owner) find() *owner {
+	root := o
+	for root.parent != nil {
+		root = root.parent
+	}
+	for o != root {
+		next := o.parent
+		o.parent = root
+		o = next
+	}
+	return root
+}
+
+// absorb links the owner of a melded-away heap to this one; the caller gives
+// the emptied heap a fresh owner
+func (o *owner) absorb(other *owner) {
+	other.parent = o
+}
+
+var (
+	_ MeldableHeap[int] = (*DaryHeap[int])(nil)
+	_ MeldableHeap[int] = (*PairingHeap[int])(nil)
+	_ MeldableHeap[int] = (*BinomialHeap[int])(nil)
+	_ MeldableHeap[int] = (*FibonacciHeap[int])(nil)
+)
@@ -0,0 +1,160 @@
+package heap
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func lessInt(a, b int) bool {
+	return a < b
+}
+
+// meldableHeaps lists a constructor for every MeldableHeap implementation
+var meldableHeaps = []struct {
+	name string
+	make func() MeldableHeap[int]
+}{
+	{"binary", func() MeldableHeap[int] { return NewDary(2, lessInt) }},
+	{"4-ary", func() MeldableHeap[int] { return NewDary(4, lessInt) }},
+	{"pairing", func() MeldableHeap[int] { return NewPairing(lessInt) }},
+	{"binomial", func() MeldableHeap[int] { return NewBinomial(lessInt) }},
+	{"Fibonacci", func() MeldableHeap[int] { return NewFibonacci(lessInt) }},
+}
+
+// oracle mirrors a heap as a plain slice of its handles
+type oracle struct {
+	heap  MeldableHeap[int]
+	items []Item[int]
+}
+
+func (o *oracle) min() int {
+	smallest := o.items[0].Value()
+	for _, item := range o.items[1:] {
+		smallest = min(smallest, item.Value())
+	}
+	return smallest
+}
+
+// TestMeldableHeapsAgainstOracle runs random inserts, extractions, decrease-keys
+// and melds on two heaps of each kind and checks every result against a
+// linear scan of the handles each heap should hold
+func TestMeldableHeapsAgainstOracle(t *testing.T) {
+	for _, impl := range meldableHeaps {
+		t.Run(impl.name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(1))
+			heaps := []*oracle{{heap: impl.make()}, {heap: impl.make()}}
+			// Values carry a serial number in their low digits so they stay
+			// unique and the oracle knows which handle ExtractMin removed
+			const unit = 1 << 20
+			for step := range 20000 {
+				o := heaps[rng.Intn(2)]
+				switch op := rng.Intn(10); {
+				case op < 4:
+					o.items = append(o.items, o.heap.Insert(rng.Intn(1000)*unit+step))
+				case op < 6:
+					value, err := o.heap.ExtractMin()
+					if len(o.items) == 0 {
+						if !errors.Is(err, ErrEmpty) {
+							t.Fatalf("step %d: ExtractMin on empty heap: err = %v", step, err)
+						}
+						continue
+					}
+					if want := o.min(); err != nil || value != want {
+						t.Fatalf("step %d: ExtractMin() = %d, %v; want %d", step, value, err, want)
+					}
+					i := slices.IndexFunc(o.items, func(item Item[int]) bool { return item.Value() == value })
+					o.items = slices.Delete(o.items, i, i+1)
+				case op < 9:
+					if len(o.items) == 0 {
+						continue
+					}
+					item := o.items[rng.Intn(len(o.items))]
+					if err := o.heap.DecreaseKey(item, item.Value()-rng.Intn(200)*unit); err != nil {
+						t.Fatalf("step %d: DecreaseKey: %v", step, err)
+					}
+					if err := o.heap.DecreaseKey(item, item.Value()+1); !errors.Is(err, ErrKeyIncreased) {
+						t.Fatalf("step %d: DecreaseKey to a larger key: err = %v, want ErrKeyIncreased", step, err)
+					}
+				default:
+					other := heaps[0]
+					if other == o {
+						other = heaps[1]
+					}
+					if err := o.heap.Meld(other.heap); err != nil {
+						t.Fatalf("step %d: Meld: %v", step, err)
+					}
+					o.items = append(o.items, other.items...)
+					other.items = nil
+				}
+				for _, o := range heaps {
+					if o.heap.Len() != len(o.items) {
+						t.Fatalf("step %d: Len() = %d, want %d", step, o.heap.Len(), len(o.items))
+					}
+					if len(o.items) > 0 {
+						if got, err := o.heap.Min(); err != nil || got != o.min() {
+							t.Fatalf("step %d: Min() = %d, %v; want %d", step, got, err, o.min())
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestMeldableHeapsRejectForeignHandles checks that DecreaseKey refuses
+// handles that were extracted, that belong to another heap, or that moved
+// away in a Meld, and that the heap is intact afterwards
+func TestMeldableHeapsRejectForeignHandles(t *testing.T) {
+	for _, impl := range meldableHeaps {
+		t.Run(impl.name, func(t *testing.T) {
+			a, b, c := impl.make(), impl.make(), impl.make()
+			for _, v := range []int{5, 3, 8} {
+				a.Insert(v)
+			}
+			fromB := b.Insert(10)
+			b.Insert(1)
+			fromEmpty := c.Insert(7)
+			c.ExtractMin()
+
+			if err := a.DecreaseKey(fromB, 0); !errors.Is(err, ErrStaleHandle) {
+				t.Fatalf("DecreaseKey with another heap's handle: err = %v, want ErrStaleHandle", err)
+			}
+			if err := c.DecreaseKey(fromEmpty, 0); !errors.Is(err, ErrStaleHandle) {
+				t.Fatalf("DecreaseKey with an extracted handle: err = %v, want ErrStaleHandle", err)
+			}
+			if err := c.DecreaseKey(fromB, 0); !errors.Is(err, ErrStaleHandle) {
+				t.Fatalf("DecreaseKey on an empty heap with a foreign handle: err = %v, want ErrStaleHandle", err)
+			}
+			if err := a.Meld(b); err != nil {
+				t.Fatal(err)
+			}
+			if err := b.DecreaseKey(fromB, 0); !errors.Is(err, ErrStaleHandle) {
+				t.Fatalf("DecreaseKey on the melded-away heap: err = %v, want ErrStaleHandle", err)
+			}
+			// the handle now belongs to a, and b works as a fresh heap
+			if err := a.DecreaseKey(fromB, 0); err != nil {
+				t.Fatalf("DecreaseKey on the absorbing heap: %v", err)
+			}
+			fromNewB := b.Insert(4)
+			if err := a.DecreaseKey(fromNewB, 0); !errors.Is(err, ErrStaleHandle) {
+				t.Fatalf("DecreaseKey with a handle inserted after Meld: err = %v, want ErrStaleHandle", err)
+			}
+			var got []int
+			for a.Len() > 0 {
+				v, _ := a.ExtractMin()
+				got = append(got, v)
+			}
+			if want := []int{0, 1, 3, 5, 8}; !slices.Equal(got, want) {
+				t.Fatalf("extracted %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestMeldIncompatible(t *testing.T) {
+	if err := NewPairing(lessInt).Meld(NewFibonacci(lessInt)); !errors.Is(err, ErrIncompatibleHeap) {
+		t.Fatalf("Meld of different kinds: err = %v, want ErrIncompatibleHeap", err)
+	}
+}
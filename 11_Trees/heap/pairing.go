@@ -0,0 +1,156 @@
+package heap
+
+// PairingHeap is a heap-ordered multiway tree. Insert and Meld just link two
+// roots; ExtractMin pairs up the root's children left to right and then melds
+// the pairs right to left, which pays for the cheap operations in amortized
+// O(log n) time.
+type PairingHeap[T any] struct {
+	root  *pairingNode[T]
+	size  int
+	less  func(a, b T) bool
+	owner *owner
+}
+
+type pairingNode[T any] struct {
+	value T
+	child *pairingNode[T] // leftmost child
+	next  *pairingNode[T] // right sibling
+	prev  *pairingNode[T] // left sibling, or the parent for a leftmost child
+	owner *owner
+	in    bool
+}
+
+// Value returns the element the handle refers to
+func (n *pairingNode[T]) Value() T {
+	return n.value
+}
+
+// NewPairing creates an empty pairing heap ordered by less
+func NewPairing[T any](less func(a, b T) bool) *PairingHeap[T] {
+	return &PairingHeap[T]{less: less, owner: &owner{}}
+}
+
+// Insert adds a value and returns a handle to it
+func (h *PairingHeap[T]) Insert(value T) Item[T] {
+	node := &pairingNode[T]{value: value, owner: h.owner, in: true}
+	h.root = h.link(h.root, node)
+	h.size++
+	return node
+}
+
+// Min returns the minimum value without removing it
+func (h *PairingHeap[T]) Min() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return h.root.value, nil
+}
+
+// ExtractMin removes and returns the minimum value
+func (h *PairingHeap[T]) ExtractMin() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	old := h.root
+	h.root = h.mergePairs(old.child)
+	old.child = nil
+	old.in = false
+	h.size--
+	return old.value, nil
+}
+
+// DecreaseKey replaces the value behind item with one that orders no later;
+// the node's subtree is cut loose and linked back in at the root
+func (h *PairingHeap[T]) DecreaseKey(item Item[T], value T) error {
+	node, ok := item.(*pairingNode[T])
+	if !ok || !node.in || node.owner.find() != h.owner {
+		return ErrStaleHandle
+	}
+	if h.less(node.value, value) {
+		return ErrKeyIncreased
+	}
+	node.value = value
+	if node == h.root {
+		return nil
+	}
+	if node.prev.child == node {
+		node.prev.child = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	}
+	node.next, node.prev = nil, nil
+	h.root = h.link(h.root, node)
+	return nil
+}
+
+// Meld moves every element of other into this heap in O(1)
+func (h *PairingHeap[T]) Meld(other MeldableHeap[T]) error {
+	o, ok := other.(*PairingHeap[T])
+	if !ok {
+		return ErrIncompatibleHeap
+	}
+	if o == h {
+		return nil
+	}
+	h.root = h.link(h.root, o.root)
+	h.size += o.size
+	h.owner.absorb(o.owner)
+	o.root, o.size, o.owner = nil, 0, &owner{}
+	return nil
+}
+
+// Len returns the number of elements in the heap
+func (h *PairingHeap[T]) Len() int {
+	return h.size
+}
+
+// link makes the larger of two roots the leftmost child of the smaller one
+func (h *PairingHeap[T]) link(a, b *pairingNode[T]) *pairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+	b.prev = a
+	b.next = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	return a
+}
+
+// mergePairs melds a sibling list into a single tree using the two-pass rule
+func (h *PairingHeap[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+	var pairs []*pairingNode[T]
+	for current := first; current != nil; {
+		a := current
+		b := a.next
+		if b == nil {
+			a.next, a.prev = nil, nil
+			pairs = append(pairs, a)
+			break
+		}
+		current = b.next
+		a.next, a.prev = nil, nil
+		b.next, b.prev = nil, nil
+		pairs = append(pairs, h.link(a, b))
+	}
+	if len(pairs) == 0 {
+		return nil
+	}
+	root := pairs[len(pairs)-1]
+	for i := len(pairs) - 2; i >= 0; i-- {
+		root = h.link(pairs[i], root)
+	}
+	return root
+}
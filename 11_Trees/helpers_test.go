@@ -0,0 +1,67 @@
+package tree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// randomTree builds a tree of n nodes with a random shape and values drawn
+// from [-spread, spread]
+func randomTree(rng *rand.Rand, n, spread int) *Node {
+	if n == 0 {
+		return nil
+	}
+	left := rng.Intn(n)
+	return &Node{
+		Value: rng.Intn(2*spread+1) - spread,
+		Left:  randomTree(rng, left, spread),
+		Right: randomTree(rng, n-1-left, spread),
+	}
+}
+
+// mustParens parses a tree written in the FormatParens form
+func mustParens(t *testing.T, s string) *BinaryTree {
+	t.Helper()
+	bt, err := ParseParens(s)
+	if err != nil {
+		t.Fatalf("ParseParens(%q): %v", s, err)
+	}
+	return bt
+}
+
+// sameShape reports whether two trees have the same structure and values
+func sameShape(a, b *Node) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Value == b.Value && sameShape(a.Left, b.Left) && sameShape(a.Right, b.Right)
+}
+
+// recursiveInOrder, recursivePreOrder and recursivePostOrder are the
+// textbook recursive traversals, used as references
+func recursiveInOrder(node *Node, out []int) []int {
+	if node == nil {
+		return out
+	}
+	out = recursiveInOrder(node.Left, out)
+	out = append(out, node.Value)
+	return recursiveInOrder(node.Right, out)
+}
+
+func recursivePreOrder(node *Node, out []int) []int {
+	if node == nil {
+		return out
+	}
+	out = append(out, node.Value)
+	out = recursivePreOrder(node.Left, out)
+	return recursivePreOrder(node.Right, out)
+}
+
+func recursivePostOrder(node *Node, out []int) []int {
+	if node == nil {
+		return out
+	}
+	out = recursivePostOrder(node.Left, out)
+	out = recursivePostOrder(node.Right, out)
+	return append(out, node.Value)
+}
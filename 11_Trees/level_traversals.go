@@ -0,0 +1,169 @@
+package tree
+
+import (
+	"slices"
+
+	stackqueue "github.com/kuldeep-bishnoi/Golang-DSA/10_Stacks_Queues"
+)
+
+// Levels returns the values grouped by depth, top to bottom, each level left to right
+func (bt *BinaryTree) Levels() [][]int {
+	var levels [][]int
+	if bt.Root == nil {
+		return levels
+	}
+	queue := &stackqueue.Queue[*Node]{}
+	queue.Enqueue(bt.Root)
+	for !queue.IsEmpty() {
+		level := make([]int, queue.Len())
+		for i := range level {
+			node, _ := queue.Dequeue()
+			level[i] = node.Value
+			if node.Left != nil {
+				queue.Enqueue(node.Left)
+			}
+			if node.Right != nil {
+				queue.Enqueue(node.Right)
+			}
+		}
+		levels = append(levels, level)
+	}
+	return levels
+}
+
+// ZigzagLevelOrder returns the values level by level, alternating between
+// left to right and right to left starting with the root level.
+// A deque lets each level be read from the end the next level is written to.
+func (bt *BinaryTree) ZigzagLevelOrder() [][]int {
+	var levels [][]int
+	if bt.Root == nil {
+		return levels
+	}
+	deque := &stackqueue.Deque[*Node]{}
+	deque.PushBack(bt.Root)
+	for leftToRight := true; !deque.IsEmpty(); leftToRight = !leftToRight {
+		level := make([]int, deque.Len())
+		for i := range level {
+			if leftToRight {
+				node, _ := deque.PopFront()
+				level[i] = node.Value
+				if node.Left != nil {
+					deque.PushBack(node.Left)
+				}
+				if node.Right != nil {
+					deque.PushBack(node.Right)
+				}
+			} else {
+				node, _ := deque.PopBack()
+				level[i] = node.Value
+				if node.Right != nil {
+					deque.PushFront(node.Right)
+				}
+				if node.Left != nil {
+					deque.PushFront(node.Left)
+				}
+			}
+		}
+		levels = append(levels, level)
+	}
+	return levels
+}
+
+// ReverseLevelOrder returns the values level by level from the deepest
+// level up to the root, each level left to right
+func (bt *BinaryTree) ReverseLevelOrder() [][]int {
+	levels := bt.Levels()
+	slices.Reverse(levels)
+	return levels
+}
+
+// VerticalOrder returns the values grouped by column, leftmost column first,
+// where the root is in column 0 and left and right children are one column
+// to either side. Within a column values are ordered top to bottom, and
+// left to right within a level.
+func (bt *BinaryTree) VerticalOrder() [][]int {
+	var columns [][]int
+	if bt.Root == nil {
+		return columns
+	}
+	type placed struct {
+		node   *Node
+		column int
+	}
+	byColumn := make(map[int][]int)
+	minColumn, maxColumn := 0, 0
+	queue := &stackqueue.Queue[placed]{}
+	queue.Enqueue(placed{bt.Root, 0})
+	for !queue.IsEmpty() {
+		p, _ := queue.Dequeue()
+		byColumn[p.column] = append(byColumn[p.column], p.node.Value)
+		minColumn, maxColumn = min(minColumn, p.column), max(maxColumn, p.column)
+		if p.node.Left != nil {
+			queue.Enqueue(placed{p.node.Left, p.column - 1})
+		}
+		if p.node.Right != nil {
+			queue.Enqueue(placed{p.node.Right, p.column + 1})
+		}
+	}
+	for column := minColumn; column <= maxColumn; column++ {
+		columns = append(columns, byColumn[column])
+	}
+	return columns
+}
+
+// BoundaryTraversal returns the values on the outline of the tree,
+// anticlockwise from the root: the left boundary top-down, the leaves left to
+// right, then the right boundary bottom-up. Each node appears once.
+func (bt *BinaryTree) BoundaryTraversal() []int {
+	root := bt.Root
+	if root == nil {
+		return nil
+	}
+	values := []int{root.Value}
+	if isLeaf(root) {
+		return values
+	}
+	for node := root.Left; node != nil && !isLeaf(node); {
+		values = append(values, node.Value)
+		if node.Left != nil {
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	values = appendLeaves(values, root)
+	var right []int
+	for node := root.Right; node != nil && !isLeaf(node); {
+		right = append(right, node.Value)
+		if node.Right != nil {
+			node = node.Right
+		} else {
+			node = node.Left
+		}
+	}
+	slices.Reverse(right)
+	return append(values, right...)
+}
+
+func isLeaf(node *Node) bool {
+	return node.Left == nil && node.Right == nil
+}
+
+// appendLeaves appends the leaf values under root, left to right
+func appendLeaves(values []int, root *Node) []int {
+	pending := &stackqueue.Stack[*Node]{}
+	pending.Push(root)
+	for !pending.IsEmpty() {
+		node, _ := pending.Pop()
+		if isLeaf(node) {
+			values = append(values, node.Value)
+		}
+		if node.Right != nil {
+			pending.Push(node.Right)
+		}
+		if node.Left != nil {
+			pending.Push(node.Left)
+		}
+	}
+	return values
+}
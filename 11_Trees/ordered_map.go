@@ -0,0 +1,301 @@
+package tree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+var (
+	// ErrEmpty is returned when querying the smallest or largest key of an empty
+	// map, or the maximum path sum of an empty tree
+	ErrEmpty = errors.New("tree: empty")
+	// ErrNotFound is returned when no key satisfies a Floor or Ceiling query
+	ErrNotFound = errors.New("tree: no such key")
+	// ErrOutOfRange is returned when a rank passed to Select or KthSmallest is out of range
+	ErrOutOfRange = errors.New("tree: rank out of range")
+)
+
+// OrderedMap is a map whose keys are kept in sorted order, so besides lookups
+// it answers order queries: smallest and largest key, nearest keys, ranks and
+// ranges
+type OrderedMap[K cmp.Ordered, V any] interface {
+	Put(key K, value V)
+	Get(key K) (V, bool)
+	Delete(key K) bool
+	Contains(key K) bool
+	Len() int
+	Min() (K, error)
+	Max() (K, error)
+	Floor(key K) (K, error)
+	Ceiling(key K) (K, error)
+	Rank(key K) int
+	Select(k int) (K, error)
+	Range(lo, hi K) iter.Seq2[K, V]
+	All() iter.Seq2[K, V]
+}
+
+var (
+	_ OrderedMap[int, int] = (*BST[int, int])(nil)
+	_ OrderedMap[int, int] = (*AVLTree[int, int])(nil)
+	_ OrderedMap[int, int] = (*RedBlackTree[int, int])(nil)
+	_ OrderedMap[int, int] = (*Treap[int, int])(nil)
+	_ OrderedMap[int, int] = (*SkipList[int, int])(nil)
+	_ OrderedMap[int, int] = (*SplayTree[int, int])(nil)
+)
+
+// mapNode is a node of an ordered map. Every node records the size of its
+// subtree, which turns rank and select into a single walk from the root.
+// height is only maintained by AVLTree, red only by RedBlackTree and
+// priority only by Treap.
+type mapNode[K cmp.Ordered, V any] struct {
+	key      K
+	value    V
+	left     *mapNode[K, V]
+	right    *mapNode[K, V]
+	size     int
+	height   int
+	red      bool
+	priority uint32
+}
+
+func size[K cmp.Ordered, V any](n *mapNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *mapNode[K, V]) resize() {
+	n.size = 1 + size(n.left) + size(n.right)
+}
+
+func getNode[K cmp.Ordered, V any](n *mapNode[K, V], key K) *mapNode[K, V] {
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func minNode[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func maxNode[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+// floorNode returns the node with the largest key <= key, or nil
+func floorNode[K cmp.Ordered, V any](n *mapNode[K, V], key K) *mapNode[K, V] {
+	var best *mapNode[K, V]
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			best, n = n, n.right
+		default:
+			return n
+		}
+	}
+	return best
+}
+
+// ceilingNode returns the node with the smallest key >= key, or nil
+func ceilingNode[K cmp.Ordered, V any](n *mapNode[K, V], key K) *mapNode[K, V] {
+	var best *mapNode[K, V]
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			best, n = n, n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return best
+}
+
+// rank returns the number of keys strictly less than key
+func rank[K cmp.Ordered, V any](n *mapNode[K, V], key K) int {
+	r := 0
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			r += size(n.left) + 1
+			n = n.right
+		default:
+			return r + size(n.left)
+		}
+	}
+	return r
+}
+
+// selectNode returns the node holding the key of rank k, counting from 0
+func selectNode[K cmp.Ordered, V any](n *mapNode[K, V], k int) *mapNode[K, V] {
+	for n != nil {
+		left := size(n.left)
+		switch {
+		case k < left:
+			n = n.left
+		case k > left:
+			k -= left + 1
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// rangeSeq yields the entries with lo <= key <= hi in ascending order,
+// skipping subtrees that lie entirely outside the range
+func rangeSeq[K cmp.Ordered, V any](n *mapNode[K, V], lo, hi K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	if cmp.Less(lo, n.key) && !rangeSeq(n.left, lo, hi, yield) {
+		return false
+	}
+	if cmp.Compare(lo, n.key) <= 0 && cmp.Compare(n.key, hi) <= 0 && !yield(n.key, n.value) {
+		return false
+	}
+	if cmp.Less(n.key, hi) {
+		return rangeSeq(n.right, lo, hi, yield)
+	}
+	return true
+}
+
+func allSeq[K cmp.Ordered, V any](n *mapNode[K, V], yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return allSeq(n.left, yield) && yield(n.key, n.value) && allSeq(n.right, yield)
+}
+
+// checkOrder verifies that every key in the subtree lies strictly between
+// lo and hi (nil meaning unbounded) and that the subtree sizes are correct
+func checkOrder[K cmp.Ordered, V any](n *mapNode[K, V], lo, hi *K) error {
+	if n == nil {
+		return nil
+	}
+	if (lo != nil && cmp.Compare(n.key, *lo) <= 0) || (hi != nil && cmp.Compare(n.key, *hi) >= 0) {
+		return fmt.Errorf("key %v is out of order", n.key)
+	}
+	if err := checkOrder(n.left, lo, &n.key); err != nil {
+		return err
+	}
+	if err := checkOrder(n.right, &n.key, hi); err != nil {
+		return err
+	}
+	if n.size != 1+size(n.left)+size(n.right) {
+		return fmt.Errorf("node %v records size %d, want %d", n.key, n.size, 1+size(n.left)+size(n.right))
+	}
+	return nil
+}
+
+// orderedQueries implements the read-only half of an ordered map on top of
+// a root pointer; the tree types embed it and supply their own updates
+type orderedQueries[K cmp.Ordered, V any] struct {
+	root *mapNode[K, V]
+}
+
+// Get returns the value stored under key and whether it was present
+func (t *orderedQueries[K, V]) Get(key K) (V, bool) {
+	if n := getNode(t.root, key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is in the map
+func (t *orderedQueries[K, V]) Contains(key K) bool {
+	return getNode(t.root, key) != nil
+}
+
+// Len returns the number of keys in the map
+func (t *orderedQueries[K, V]) Len() int {
+	return size(t.root)
+}
+
+// Min returns the smallest key
+func (t *orderedQueries[K, V]) Min() (K, error) {
+	if t.root == nil {
+		var zero K
+		return zero, ErrEmpty
+	}
+	return minNode(t.root).key, nil
+}
+
+// Max returns the largest key
+func (t *orderedQueries[K, V]) Max() (K, error) {
+	if t.root == nil {
+		var zero K
+		return zero, ErrEmpty
+	}
+	return maxNode(t.root).key, nil
+}
+
+// Floor returns the largest key less than or equal to key
+func (t *orderedQueries[K, V]) Floor(key K) (K, error) {
+	if n := floorNode(t.root, key); n != nil {
+		return n.key, nil
+	}
+	var zero K
+	return zero, ErrNotFound
+}
+
+// Ceiling returns the smallest key greater than or equal to key
+func (t *orderedQueries[K, V]) Ceiling(key K) (K, error) {
+	if n := ceilingNode(t.root, key); n != nil {
+		return n.key, nil
+	}
+	var zero K
+	return zero, ErrNotFound
+}
+
+// Rank returns the number of keys strictly less than key
+func (t *orderedQueries[K, V]) Rank(key K) int {
+	return rank(t.root, key)
+}
+
+// Select returns the key of rank k, i.e. the (k+1)-th smallest key
+func (t *orderedQueries[K, V]) Select(k int) (K, error) {
+	if k < 0 || k >= size(t.root) {
+		var zero K
+		return zero, ErrOutOfRange
+	}
+	return selectNode(t.root, k).key, nil
+}
+
+// Range returns an iterator over the entries with lo <= key <= hi in ascending order
+func (t *orderedQueries[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		rangeSeq(t.root, lo, hi, yield)
+	}
+}
+
+// All returns an iterator over all entries in ascending key order
+func (t *orderedQueries[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		allSeq(t.root, yield)
+	}
+}
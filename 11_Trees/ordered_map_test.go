@@ -0,0 +1,497 @@
+package tree
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// checkedMap is an OrderedMap whose structural invariants can be verified
+type checkedMap interface {
+	OrderedMap[int, int]
+	CheckInvariants() error
+}
+
+// orderedMaps lists the ordered maps under test. maxHeight bounds the
+// height of a map holding n keys, or is nil when the height is not bounded
+// in the worst case.
+var orderedMaps = []struct {
+	name      string
+	make      func() checkedMap
+	maxHeight func(n int) int
+}{
+	{"BST", func() checkedMap { return &BST[int, int]{} }, nil},
+	{"AVL", func() checkedMap { return &AVLTree[int, int]{} }, func(n int) int {
+		return int(1.44 * math.Log2(float64(n+2)))
+	}},
+	{"red-black", func() checkedMap { return &RedBlackTree[int, int]{} }, func(n int) int {
+		return int(2 * math.Log2(float64(n+1)))
+	}},
+	{"treap", func() checkedMap { return &Treap[int, int]{} }, nil},
+	{"skip list", func() checkedMap { return NewSkipList[int, int](0.5) }, nil},
+	{"sparse skip list", func() checkedMap { return NewSkipList[int, int](0.25) }, nil},
+	{"zero skip list", func() checkedMap { return &SkipList[int, int]{} }, nil},
+	{"splay", func() checkedMap { return &SplayTree[int, int]{} }, nil},
+}
+
+// mapOracle is the reference an ordered map is compared against: a Go map
+// for the values and a sorted slice for the order queries
+type mapOracle struct {
+	values map[int]int
+	keys   []int
+}
+
+func (o *mapOracle) put(key, value int) {
+	if _, ok := o.values[key]; !ok {
+		i, _ := slices.BinarySearch(o.keys, key)
+		o.keys = slices.Insert(o.keys, i, key)
+	}
+	o.values[key] = value
+}
+
+func (o *mapOracle) delete(key int) bool {
+	i, ok := slices.BinarySearch(o.keys, key)
+	if ok {
+		o.keys = slices.Delete(o.keys, i, i+1)
+		delete(o.values, key)
+	}
+	return ok
+}
+
+// compare checks every query of m against the oracle, probing around key
+func (o *mapOracle) compare(t *testing.T, m OrderedMap[int, int], key int) {
+	t.Helper()
+	if m.Len() != len(o.keys) {
+		t.Fatalf("Len() = %d, want %d", m.Len(), len(o.keys))
+	}
+	want, wantOK := o.values[key]
+	if got, ok := m.Get(key); ok != wantOK || got != want {
+		t.Fatalf("Get(%d) = %d, %v; want %d, %v", key, got, ok, want, wantOK)
+	}
+	if m.Contains(key) != wantOK {
+		t.Fatalf("Contains(%d) = %v, want %v", key, !wantOK, wantOK)
+	}
+
+	i, found := slices.BinarySearch(o.keys, key)
+	if got := m.Rank(key); got != i {
+		t.Fatalf("Rank(%d) = %d, want %d", key, got, i)
+	}
+	checkKey(t, "Floor", key, m.Floor, func() (int, error) {
+		switch {
+		case found:
+			return key, nil
+		case i > 0:
+			return o.keys[i-1], nil
+		}
+		return 0, ErrNotFound
+	})
+	checkKey(t, "Ceiling", key, m.Ceiling, func() (int, error) {
+		if i < len(o.keys) {
+			return o.keys[i], nil
+		}
+		return 0, ErrNotFound
+	})
+	checkKey(t, "Select", i, m.Select, func() (int, error) {
+		if i < len(o.keys) {
+			return o.keys[i], nil
+		}
+		return 0, ErrOutOfRange
+	})
+	if len(o.keys) == 0 {
+		if _, err := m.Min(); !errors.Is(err, ErrEmpty) {
+			t.Fatalf("Min() of empty map: err = %v, want ErrEmpty", err)
+		}
+		if _, err := m.Max(); !errors.Is(err, ErrEmpty) {
+			t.Fatalf("Max() of empty map: err = %v, want ErrEmpty", err)
+		}
+	} else {
+		if got, _ := m.Min(); got != o.keys[0] {
+			t.Fatalf("Min() = %d, want %d", got, o.keys[0])
+		}
+		if got, _ := m.Max(); got != o.keys[len(o.keys)-1] {
+			t.Fatalf("Max() = %d, want %d", got, o.keys[len(o.keys)-1])
+		}
+	}
+
+	lo, hi := key-10, key+10
+	var got, wantKeys []int
+	for k, v := range m.Range(lo, hi) {
+		if v != o.values[k] {
+			t.Fatalf("Range(%d, %d) yielded %d=%d, want %d", lo, hi, k, v, o.values[k])
+		}
+		got = append(got, k)
+	}
+	for _, k := range o.keys {
+		if lo <= k && k <= hi {
+			wantKeys = append(wantKeys, k)
+		}
+	}
+	if !slices.Equal(got, wantKeys) {
+		t.Fatalf("Range(%d, %d) = %v, want %v", lo, hi, got, wantKeys)
+	}
+}
+
+// checkKey compares a query returning a key or an error with the oracle's answer
+func checkKey(t *testing.T, name string, arg int, query func(int) (int, error), oracle func() (int, error)) {
+	t.Helper()
+	got, err := query(arg)
+	want, wantErr := oracle()
+	if !errors.Is(err, wantErr) || (wantErr == nil && got != want) {
+		t.Fatalf("%s(%d) = %d, %v; want %d, %v", name, arg, got, err, want, wantErr)
+	}
+}
+
+// runAgainstOracle applies random puts and deletes to m, verifying its
+// invariants and comparing every query with the oracle after each step
+func runAgainstOracle(t *testing.T, m checkedMap, rng *rand.Rand, steps, keys int) {
+	t.Helper()
+	o := &mapOracle{values: map[int]int{}}
+	for step := range steps {
+		key := rng.Intn(keys)
+		if rng.Intn(3) > 0 {
+			m.Put(key, step)
+			o.put(key, step)
+		} else if got, want := m.Delete(key), o.delete(key); got != want {
+			t.Fatalf("step %d: Delete(%d) = %v, want %v", step, key, got, want)
+		}
+		if err := m.CheckInvariants(); err != nil {
+			t.Fatalf("step %d: %v", step, err)
+		}
+		o.compare(t, m, rng.Intn(keys+20)-10)
+	}
+	var all []int
+	for k := range m.All() {
+		all = append(all, k)
+	}
+	if !slices.Equal(all, o.keys) {
+		t.Fatalf("All() = %v, want %v", all, o.keys)
+	}
+}
+
+func TestOrderedMapsAgainstOracle(t *testing.T) {
+	for _, tt := range orderedMaps {
+		t.Run(tt.name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(1))
+			for range 5 {
+				runAgainstOracle(t, tt.make(), rng, 2000, 300)
+			}
+		})
+	}
+}
+
+// floatMaps lists the ordered maps checked with float64 keys, where NaN
+// must be ordered as cmp.Compare orders it: equal to itself and before
+// every other key
+var floatMaps = []struct {
+	name string
+	make func() floatMap
+}{
+	{"BST", func() floatMap { return &BST[float64, int]{} }},
+	{"AVL", func() floatMap { return &AVLTree[float64, int]{} }},
+	{"red-black", func() floatMap { return &RedBlackTree[float64, int]{} }},
+	{"treap", func() floatMap { return &Treap[float64, int]{} }},
+	{"skip list", func() floatMap { return NewSkipList[float64, int](0.5) }},
+	{"splay", func() floatMap { return &SplayTree[float64, int]{} }},
+}
+
+type floatMap interface {
+	OrderedMap[float64, int]
+	CheckInvariants() error
+}
+
+func sameFloats(a, b []float64) bool {
+	return slices.EqualFunc(a, b, func(x, y float64) bool { return cmp.Compare(x, y) == 0 })
+}
+
+func TestOrderedMapsNaNKeys(t *testing.T) {
+	nan := math.NaN()
+	for _, tt := range floatMaps {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.make()
+			for i, k := range []float64{2, nan, math.Inf(1), -1, math.Inf(-1), 0.5} {
+				m.Put(k, i)
+			}
+			m.Put(nan, 10)
+			if err := m.CheckInvariants(); err != nil {
+				t.Fatal(err)
+			}
+			if m.Len() != 6 {
+				t.Fatalf("Len() = %d, want 6", m.Len())
+			}
+			if v, ok := m.Get(nan); !ok || v != 10 || !m.Contains(nan) {
+				t.Fatalf("Get(NaN) = %d, %v; want 10, true", v, ok)
+			}
+			if k, err := m.Min(); err != nil || !math.IsNaN(k) {
+				t.Fatalf("Min() = %v, %v; want NaN", k, err)
+			}
+			if got := m.Rank(math.Inf(-1)); got != 1 {
+				t.Fatalf("Rank(-Inf) = %d, want 1", got)
+			}
+			if k, err := m.Floor(math.Inf(-1)); err != nil || !math.IsInf(k, -1) {
+				t.Fatalf("Floor(-Inf) = %v, %v; want -Inf", k, err)
+			}
+			var got []float64
+			for k := range m.Range(nan, 0.5) {
+				got = append(got, k)
+			}
+			if want := []float64{nan, math.Inf(-1), -1, 0.5}; !sameFloats(got, want) {
+				t.Fatalf("Range(NaN, 0.5) = %v, want %v", got, want)
+			}
+			if !m.Delete(nan) || m.Delete(nan) || m.Contains(nan) || m.Len() != 5 {
+				t.Fatal("Delete(NaN) did not remove the key exactly once")
+			}
+			if err := m.CheckInvariants(); err != nil {
+				t.Fatal(err)
+			}
+
+			// random puts and deletes with NaN among the keys, compared
+			// with a slice kept sorted by cmp.Compare
+			rng := rand.New(rand.NewSource(1))
+			m = tt.make()
+			var keys []float64
+			for step := range 2000 {
+				key := nan
+				if n := rng.Intn(40); n > 0 {
+					key = float64(n-20) / 2
+				}
+				i, found := slices.BinarySearchFunc(keys, key, cmp.Compare[float64])
+				if rng.Intn(3) > 0 {
+					m.Put(key, step)
+					if !found {
+						keys = slices.Insert(keys, i, key)
+					}
+				} else {
+					if got := m.Delete(key); got != found {
+						t.Fatalf("step %d: Delete(%v) = %v, want %v", step, key, got, found)
+					}
+					if found {
+						keys = slices.Delete(keys, i, i+1)
+					}
+				}
+				if err := m.CheckInvariants(); err != nil {
+					t.Fatalf("step %d: %v", step, err)
+				}
+				var all []float64
+				for k := range m.All() {
+					all = append(all, k)
+				}
+				if !sameFloats(all, keys) {
+					t.Fatalf("step %d: All() = %v, want %v", step, all, keys)
+				}
+				if _, present := slices.BinarySearchFunc(keys, nan, cmp.Compare[float64]); m.Contains(nan) != present {
+					t.Fatalf("step %d: Contains(NaN) = %v, want %v", step, !present, present)
+				}
+			}
+		})
+	}
+}
+
+// TestOrderedMapsSortedKeys feeds keys in ascending and descending order,
+// the worst case for an unbalanced tree, and deletes them the same way
+func TestOrderedMapsSortedKeys(t *testing.T) {
+	const n = 1000
+	for _, tt := range orderedMaps {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, descending := range []bool{false, true} {
+				m := tt.make()
+				keyAt := func(i int) int {
+					if descending {
+						return n - 1 - i
+					}
+					return i
+				}
+				for i := range n {
+					m.Put(keyAt(i), i)
+					if err := m.CheckInvariants(); err != nil {
+						t.Fatalf("after inserting %d keys: %v", i+1, err)
+					}
+				}
+				if h, ok := m.(interface{ Height() int }); ok && tt.maxHeight != nil {
+					if limit := tt.maxHeight(n); h.Height() > limit {
+						t.Fatalf("height %d for %d sorted keys, want at most %d", h.Height(), n, limit)
+					}
+				}
+				for i := range n {
+					if !m.Delete(keyAt(i)) {
+						t.Fatalf("Delete(%d) did not find the key", keyAt(i))
+					}
+					if err := m.CheckInvariants(); err != nil {
+						t.Fatalf("after deleting %d keys: %v", i+1, err)
+					}
+				}
+				if m.Len() != 0 {
+					t.Fatalf("Len() = %d after deleting every key", m.Len())
+				}
+			}
+		})
+	}
+}
+
+// bstFrom inserts keys in order, storing ten times each key as its value
+func bstFrom(keys ...int) *BST[int, int] {
+	m := &BST[int, int]{}
+	for _, k := range keys {
+		m.Put(k, 10*k)
+	}
+	return m
+}
+
+// bstShape renders the key layout of a BST in the parenthesized format
+func bstShape(n *mapNode[int, int]) string {
+	if n == nil {
+		return ""
+	}
+	s := strconv.Itoa(n.key)
+	left, right := bstShape(n.left), bstShape(n.right)
+	if left != "" || right != "" {
+		s += "(" + left + ")"
+	}
+	if right != "" {
+		s += "(" + right + ")"
+	}
+	return s
+}
+
+func TestBSTDelete(t *testing.T) {
+	tests := []struct {
+		name   string
+		keys   []int
+		delete int
+		found  bool
+		shape  string
+	}{
+		{"leaf", []int{5, 3, 8}, 3, true, "5()(8)"},
+		{"one child", []int{5, 3, 2}, 3, true, "5(2)"},
+		{"two children, successor is the right child", []int{5, 3, 8, 9}, 5, true, "8(3)(9)"},
+		// the successor 55 is spliced out and its right child 57 takes its place
+		{"root with a deep successor", []int{50, 30, 70, 60, 80, 55, 57}, 50, true, "55(30)(70(60(57))(80))"},
+		{"inner node with two children", []int{50, 30, 70, 20, 40, 35}, 30, true, "50(35(20)(40))(70)"},
+		{"only node", []int{1}, 1, true, ""},
+		{"root with one child", []int{1, 2}, 1, true, "2"},
+		{"missing", []int{5, 3, 8}, 4, false, "5(3)(8)"},
+	}
+	for _, tt := range tests {
+		m := bstFrom(tt.keys...)
+		if got := m.Delete(tt.delete); got != tt.found {
+			t.Fatalf("%s: Delete(%d) = %v, want %v", tt.name, tt.delete, got, tt.found)
+		}
+		if err := m.CheckInvariants(); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got := bstShape(m.root); got != tt.shape {
+			t.Fatalf("%s: tree after Delete(%d) = %s, want %s", tt.name, tt.delete, got, tt.shape)
+		}
+		if m.Contains(tt.delete) {
+			t.Fatalf("%s: Contains(%d) after Delete", tt.name, tt.delete)
+		}
+		// every other key keeps its own value after nodes are moved
+		for _, k := range tt.keys {
+			if v, ok := m.Get(k); k != tt.delete && (!ok || v != 10*k) {
+				t.Fatalf("%s: Get(%d) = %d, %v after Delete(%d); want %d", tt.name, k, v, ok, tt.delete, 10*k)
+			}
+		}
+	}
+}
+
+func TestBSTOrderQueriesAtTheEdges(t *testing.T) {
+	m := bstFrom(20, 10, 30)
+	empty := &BST[int, int]{}
+	tests := []struct {
+		name    string
+		query   func(int) (int, error)
+		arg     int
+		want    int
+		wantErr error
+	}{
+		{"Floor below the minimum", m.Floor, 5, 0, ErrNotFound},
+		{"Floor of the minimum", m.Floor, 10, 10, nil},
+		{"Floor between keys", m.Floor, 15, 10, nil},
+		{"Floor above the maximum", m.Floor, 35, 30, nil},
+		{"Ceiling below the minimum", m.Ceiling, 5, 10, nil},
+		{"Ceiling between keys", m.Ceiling, 25, 30, nil},
+		{"Ceiling of the maximum", m.Ceiling, 30, 30, nil},
+		{"Ceiling above the maximum", m.Ceiling, 35, 0, ErrNotFound},
+		{"Select of a negative rank", m.Select, -1, 0, ErrOutOfRange},
+		{"Select of the first rank", m.Select, 0, 10, nil},
+		{"Select of the last rank", m.Select, 2, 30, nil},
+		{"Select past the end", m.Select, 3, 0, ErrOutOfRange},
+		{"Floor of an empty map", empty.Floor, 1, 0, ErrNotFound},
+		{"Ceiling of an empty map", empty.Ceiling, 1, 0, ErrNotFound},
+		{"Select of an empty map", empty.Select, 0, 0, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		if got, err := tt.query(tt.arg); !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && got != tt.want) {
+			t.Errorf("%s: got %d, %v; want %d, %v", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+	for key, want := range map[int]int{5: 0, 10: 0, 15: 1, 30: 2, 35: 3} {
+		if got := m.Rank(key); got != want {
+			t.Errorf("Rank(%d) = %d, want %d", key, got, want)
+		}
+	}
+	if got := empty.Rank(1); got != 0 {
+		t.Errorf("Rank(1) of an empty map = %d, want 0", got)
+	}
+}
+
+func TestTreapSplitMerge(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 50 {
+		var tr Treap[int, int]
+		for _, key := range rng.Perm(200)[:rng.Intn(200)] {
+			tr.Put(key, key)
+		}
+		wantLen := tr.Len()
+		at := rng.Intn(220) - 10
+		upper := tr.Split(at)
+		for _, half := range []*Treap[int, int]{&tr, upper} {
+			if err := half.CheckInvariants(); err != nil {
+				t.Fatalf("after Split(%d): %v", at, err)
+			}
+		}
+		if largest, err := tr.Max(); err == nil && largest >= at {
+			t.Fatalf("Split(%d) left %d in the lower half", at, largest)
+		}
+		if smallest, err := upper.Min(); err == nil && smallest < at {
+			t.Fatalf("Split(%d) moved %d to the upper half", at, smallest)
+		}
+		if err := upper.Merge(&tr); upper.Len() > 0 && tr.Len() > 0 && !errors.Is(err, ErrKeyOrder) {
+			t.Fatalf("Merge of overlapping treaps: err = %v, want ErrKeyOrder", err)
+		}
+		if err := tr.Merge(upper); err != nil {
+			t.Fatalf("Merge after Split(%d): %v", at, err)
+		}
+		if err := tr.CheckInvariants(); err != nil {
+			t.Fatalf("after Merge: %v", err)
+		}
+		if tr.Len() != wantLen || upper.Len() != 0 {
+			t.Fatalf("Merge left %d and %d keys, want %d and 0", tr.Len(), upper.Len(), wantLen)
+		}
+	}
+}
+
+func TestSplayMovesKeyToRoot(t *testing.T) {
+	var s SplayTree[int, int]
+	for key := range 100 {
+		s.Put(key, key)
+	}
+	for _, key := range []int{0, 57, 99, 13} {
+		s.Get(key)
+		if s.root.key != key {
+			t.Fatalf("root is %d after Get(%d)", s.root.key, key)
+		}
+		if err := s.CheckInvariants(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// a missing key splays its neighbour to the root
+	s.Delete(40)
+	s.Get(40)
+	if s.root.key != 39 && s.root.key != 41 {
+		t.Fatalf("root is %d after Get of the missing key 40", s.root.key)
+	}
+}
@@ -0,0 +1,217 @@
+package tree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+// RedBlackTree is an ordered map backed by a left-leaning red-black tree.
+// Every root-to-leaf path has the same number of black nodes, no red node
+// has a red child and red nodes only hang to the left, so the tree is a
+// binary encoding of a 2-3 tree and its height stays below 2 log2(n).
+// The zero value is an empty map ready to use.
+type RedBlackTree[K cmp.Ordered, V any] struct {
+	orderedQueries[K, V]
+}
+
+func isRed[K cmp.Ordered, V any](n *mapNode[K, V]) bool {
+	return n != nil && n.red
+}
+
+func rbRotateLeft[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	x.red = n.red
+	n.red = true
+	x.size = n.size
+	n.resize()
+	return x
+}
+
+func rbRotateRight[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	x.red = n.red
+	n.red = true
+	x.size = n.size
+	n.resize()
+	return x
+}
+
+// flipColors splits or joins the 4-node formed by n and its two children
+func flipColors[K cmp.Ordered, V any](n *mapNode[K, V]) {
+	n.red = !n.red
+	n.left.red = !n.left.red
+	n.right.red = !n.right.red
+}
+
+// rbFixUp restores the left-leaning invariants on the way back up
+func rbFixUp[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	if isRed(n.right) && !isRed(n.left) {
+		n = rbRotateLeft(n)
+	}
+	if isRed(n.left) && isRed(n.left.left) {
+		n = rbRotateRight(n)
+	}
+	if isRed(n.left) && isRed(n.right) {
+		flipColors(n)
+	}
+	n.resize()
+	return n
+}
+
+// Put stores value under key, replacing any previous value
+func (t *RedBlackTree[K, V]) Put(key K, value V) {
+	t.root = rbPut(t.root, key, value)
+	t.root.red = false
+}
+
+func rbPut[K cmp.Ordered, V any](n *mapNode[K, V], key K, value V) *mapNode[K, V] {
+	if n == nil {
+		return &mapNode[K, V]{key: key, value: value, size: 1, red: true}
+	}
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = rbPut(n.left, key, value)
+	case c > 0:
+		n.right = rbPut(n.right, key, value)
+	default:
+		n.value = value
+	}
+	return rbFixUp(n)
+}
+
+// Delete removes key from the map and reports whether it was present.
+// On the way down a red link is pushed ahead of the search so the node
+// finally removed is never a lone black node.
+func (t *RedBlackTree[K, V]) Delete(key K) bool {
+	if getNode(t.root, key) == nil {
+		return false
+	}
+	if !isRed(t.root.left) && !isRed(t.root.right) {
+		t.root.red = true
+	}
+	t.root = rbDelete(t.root, key)
+	if t.root != nil {
+		t.root.red = false
+	}
+	return true
+}
+
+// moveRedLeft makes n.left or one of its children red, assuming n is red
+// and both n.left and n.left.left are black
+func moveRedLeft[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	flipColors(n)
+	if isRed(n.right.left) {
+		n.right = rbRotateRight(n.right)
+		n = rbRotateLeft(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// moveRedRight makes n.right or one of its children red, assuming n is red
+// and both n.right and n.right.left are black
+func moveRedRight[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	flipColors(n)
+	if isRed(n.left.left) {
+		n = rbRotateRight(n)
+		flipColors(n)
+	}
+	return n
+}
+
+func rbDelete[K cmp.Ordered, V any](n *mapNode[K, V], key K) *mapNode[K, V] {
+	if cmp.Less(key, n.key) {
+		if !isRed(n.left) && !isRed(n.left.left) {
+			n = moveRedLeft(n)
+		}
+		n.left = rbDelete(n.left, key)
+		return rbFixUp(n)
+	}
+	if isRed(n.left) {
+		n = rbRotateRight(n)
+	}
+	if cmp.Compare(key, n.key) == 0 && n.right == nil {
+		return nil
+	}
+	if !isRed(n.right) && !isRed(n.right.left) {
+		n = moveRedRight(n)
+	}
+	if cmp.Compare(key, n.key) == 0 {
+		successor := minNode(n.right)
+		n.key, n.value = successor.key, successor.value
+		n.right = rbDeleteMin(n.right)
+	} else {
+		n.right = rbDelete(n.right, key)
+	}
+	return rbFixUp(n)
+}
+
+func rbDeleteMin[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	if n.left == nil {
+		return nil
+	}
+	if !isRed(n.left) && !isRed(n.left.left) {
+		n = moveRedLeft(n)
+	}
+	n.left = rbDeleteMin(n.left)
+	return rbFixUp(n)
+}
+
+// Height returns the number of nodes on the longest root-to-leaf path
+func (t *RedBlackTree[K, V]) Height() int {
+	return treeHeight(t.root)
+}
+
+func treeHeight[K cmp.Ordered, V any](n *mapNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return 1 + max(treeHeight(n.left), treeHeight(n.right))
+}
+
+// CheckInvariants verifies key order, subtree sizes and the red-black
+// rules: a black root, no red node with a red child, red nodes only as left
+// children and the same black height on every path
+func (t *RedBlackTree[K, V]) CheckInvariants() error {
+	if err := checkOrder(t.root, nil, nil); err != nil {
+		return err
+	}
+	if isRed(t.root) {
+		return errors.New("root is red")
+	}
+	_, err := checkRedBlack(t.root)
+	return err
+}
+
+// checkRedBlack returns the black height of the subtree rooted at n
+func checkRedBlack[K cmp.Ordered, V any](n *mapNode[K, V]) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if isRed(n.right) {
+		return 0, fmt.Errorf("node %v has a red right child", n.key)
+	}
+	if isRed(n) && isRed(n.left) {
+		return 0, fmt.Errorf("red node %v has a red child", n.key)
+	}
+	left, err := checkRedBlack(n.left)
+	if err != nil {
+		return 0, err
+	}
+	right, err := checkRedBlack(n.right)
+	if err != nil {
+		return 0, err
+	}
+	if left != right {
+		return 0, fmt.Errorf("node %v has black heights %d and %d", n.key, left, right)
+	}
+	if !n.red {
+		left++
+	}
+	return left, nil
+}
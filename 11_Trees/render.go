@@ -0,0 +1,168 @@
+package tree
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RenderSideways draws the tree rotated a quarter turn anticlockwise: the
+// root on the left, right subtrees above their parent and left subtrees below
+//
+//	┌── 7
+//	5
+//	│   ┌── 4
+//	└── 3
+//	    └── 2
+func (bt *BinaryTree) RenderSideways() string {
+	if bt.Root == nil {
+		return ""
+	}
+	var sb strings.Builder
+	var render func(node *Node, prefix, connector, above, below string)
+	render = func(node *Node, prefix, connector, above, below string) {
+		if node.Right != nil {
+			render(node.Right, prefix+above, "┌── ", "    ", "│   ")
+		}
+		sb.WriteString(prefix + connector + strconv.Itoa(node.Value) + "\n")
+		if node.Left != nil {
+			render(node.Left, prefix+below, "└── ", "│   ", "    ")
+		}
+	}
+	render(bt.Root, "", "", "", "")
+	return sb.String()
+}
+
+// block is a rendered subtree: rows of equal width and the column above
+// which the subtree's root label is centred
+type block struct {
+	rows  [][]rune
+	width int
+	root  int
+}
+
+// RenderTopDown draws the tree with the root on top and box-drawing
+// connectors down to each child
+//
+//	  5
+//	 ┌┴─┐
+//	 3  7
+//	┌┴┐
+//	2 4
+func (bt *BinaryTree) RenderTopDown() string {
+	if bt.Root == nil {
+		return ""
+	}
+	var sb strings.Builder
+	for _, row := range layout(bt.Root).rows {
+		sb.WriteString(strings.TrimRight(string(row), " "))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// layout renders the children side by side, then puts the connector row
+// and the label above them, shifting everything right if the label would
+// stick out on the left
+func layout(node *Node) block {
+	label := []rune(strconv.Itoa(node.Value))
+	if node.Left == nil && node.Right == nil {
+		return block{rows: [][]rune{label}, width: len(label), root: len(label) / 2}
+	}
+
+	var left, right block
+	rightX := 0
+	var mid, leftRoot, rightRoot int
+	switch {
+	case node.Left != nil && node.Right != nil:
+		left, right = layout(node.Left), layout(node.Right)
+		rightX = left.width + 1
+		leftRoot, rightRoot = left.root, rightX+right.root
+		mid = (leftRoot + rightRoot) / 2
+	case node.Left != nil:
+		left = layout(node.Left)
+		leftRoot = left.root
+		mid = leftRoot + 1
+	default:
+		right = layout(node.Right)
+		rightX = max(0, 1-right.root)
+		rightRoot = rightX + right.root
+		mid = rightRoot - 1
+	}
+
+	shift := max(0, len(label)/2-mid)
+	labelX := mid + shift - len(label)/2
+	width := max(labelX+len(label), shift+left.width, shift+rightX+right.width)
+	newRow := func() []rune {
+		return []rune(strings.Repeat(" ", width))
+	}
+
+	top := newRow()
+	copy(top[labelX:], label)
+	connector := newRow()
+	if node.Left != nil {
+		for x := leftRoot; x < mid; x++ {
+			connector[x+shift] = '─'
+		}
+		connector[leftRoot+shift] = '┌'
+		connector[mid+shift] = '┘'
+	}
+	if node.Right != nil {
+		for x := mid + 1; x < rightRoot; x++ {
+			connector[x+shift] = '─'
+		}
+		connector[rightRoot+shift] = '┐'
+		connector[mid+shift] = '└'
+	}
+	if node.Left != nil && node.Right != nil {
+		connector[mid+shift] = '┴'
+	}
+
+	rows := [][]rune{top, connector}
+	for i := range max(len(left.rows), len(right.rows)) {
+		row := newRow()
+		if i < len(left.rows) {
+			copy(row[shift:], left.rows[i])
+		}
+		if i < len(right.rows) {
+			copy(row[shift+rightX:], right.rows[i])
+		}
+		rows = append(rows, row)
+	}
+	return block{rows: rows, width: width, root: mid + shift}
+}
+
+// DOT returns the tree in the Graphviz DOT language. A node with a single
+// child gets an invisible placeholder for the missing one, so Graphviz still
+// draws the child to the correct side.
+func (bt *BinaryTree) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph BinaryTree {\n")
+	sb.WriteString("\tgraph [ordering=out];\n")
+	sb.WriteString("\tnode [shape=circle];\n")
+	// Nodes are numbered in pre-order, since values need not be unique
+	next := 0
+	var write func(node *Node)
+	write = func(node *Node) {
+		id := next
+		next++
+		fmt.Fprintf(&sb, "\tn%d [label=\"%d\"];\n", id, node.Value)
+		if node.Left == nil && node.Right == nil {
+			return
+		}
+		for _, child := range []*Node{node.Left, node.Right} {
+			if child == nil {
+				fmt.Fprintf(&sb, "\tnull%d [style=invis];\n", id)
+				fmt.Fprintf(&sb, "\tn%d -> null%d [style=invis];\n", id, id)
+				continue
+			}
+			fmt.Fprintf(&sb, "\tn%d -> n%d;\n", id, next)
+			write(child)
+		}
+	}
+	if bt.Root != nil {
+		write(bt.Root)
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
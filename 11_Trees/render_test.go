@@ -0,0 +1,216 @@
+package tree
+
+import (
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// lines joins rows into the newline-terminated form the renderers return
+func lines(rows ...string) string {
+	return strings.Join(rows, "\n") + "\n"
+}
+
+func TestRenderGolden(t *testing.T) {
+	tests := []struct {
+		tree     string
+		topDown  string
+		sideways string
+	}{
+		{"", "", ""},
+		{"1", lines("1"), lines("1")},
+		{"1(2)",
+			lines(
+				" 1",
+				"┌┘",
+				"2",
+			),
+			lines(
+				"1",
+				"└── 2",
+			)},
+		{"1()(2)",
+			lines(
+				"1",
+				"└┐",
+				" 2",
+			),
+			lines(
+				"┌── 2",
+				"1",
+			)},
+		{"5(3(2)(4))(7)",
+			lines(
+				"  5",
+				" ┌┴─┐",
+				" 3  7",
+				"┌┴┐",
+				"2 4",
+			),
+			lines(
+				"┌── 7",
+				"5",
+				"│   ┌── 4",
+				"└── 3",
+				"    └── 2",
+			)},
+		// wide and negative labels stay centred over their connectors
+		{"100(-7)(3()(12345))",
+			lines(
+				" 100",
+				" ┌┴─┐",
+				"-7  3",
+				"    └┐",
+				"   12345",
+			),
+			lines(
+				"    ┌── 12345",
+				"┌── 3",
+				"100",
+				"└── -7",
+			)},
+		{"1(2(3(4)))",
+			lines(
+				"   1",
+				"  ┌┘",
+				"  2",
+				" ┌┘",
+				" 3",
+				"┌┘",
+				"4",
+			),
+			lines(
+				"1",
+				"└── 2",
+				"    └── 3",
+				"        └── 4",
+			)},
+		{"10(5(1)(7))(15()(20(17)))",
+			lines(
+				"  10",
+				" ┌─┴─┐",
+				" 5  15",
+				"┌┴┐  └┐",
+				"1 7  20",
+				"     ┌┘",
+				"    17",
+			),
+			lines(
+				"    ┌── 20",
+				"    │   └── 17",
+				"┌── 15",
+				"10",
+				"│   ┌── 7",
+				"└── 5",
+				"    └── 1",
+			)},
+	}
+	for _, tt := range tests {
+		bt := mustParens(t, tt.tree)
+		if got := bt.RenderTopDown(); got != tt.topDown {
+			t.Errorf("RenderTopDown(%s) =\n%s\nwant\n%s", tt.tree, got, tt.topDown)
+		}
+		if got := bt.RenderSideways(); got != tt.sideways {
+			t.Errorf("RenderSideways(%s) =\n%s\nwant\n%s", tt.tree, got, tt.sideways)
+		}
+	}
+}
+
+func TestDOTGolden(t *testing.T) {
+	tests := []struct {
+		tree string
+		want string
+	}{
+		{"", lines(
+			"digraph BinaryTree {",
+			"\tgraph [ordering=out];",
+			"\tnode [shape=circle];",
+			"}",
+		)},
+		// 2 has only a right child, so it gets an invisible left placeholder
+		{"1(2()(4))(3)", lines(
+			"digraph BinaryTree {",
+			"\tgraph [ordering=out];",
+			"\tnode [shape=circle];",
+			"\tn0 [label=\"1\"];",
+			"\tn0 -> n1;",
+			"\tn1 [label=\"2\"];",
+			"\tnull1 [style=invis];",
+			"\tn1 -> null1 [style=invis];",
+			"\tn1 -> n2;",
+			"\tn2 [label=\"4\"];",
+			"\tn0 -> n3;",
+			"\tn3 [label=\"3\"];",
+			"}",
+		)},
+		// equal values still get distinct node IDs
+		{"7(7)", lines(
+			"digraph BinaryTree {",
+			"\tgraph [ordering=out];",
+			"\tnode [shape=circle];",
+			"\tn0 [label=\"7\"];",
+			"\tn0 -> n1;",
+			"\tn1 [label=\"7\"];",
+			"\tnull0 [style=invis];",
+			"\tn0 -> null0 [style=invis];",
+			"}",
+		)},
+	}
+	for _, tt := range tests {
+		if got := mustParens(t, tt.tree).DOT(); got != tt.want {
+			t.Errorf("DOT(%s) =\n%s\nwant\n%s", tt.tree, got, tt.want)
+		}
+	}
+}
+
+// TestRenderRandomTrees checks properties that hold for any tree: the
+// sideways rendering lists the values in reverse in-order one per line, the
+// top-down rendering has no trailing spaces and shows every label, and the
+// DOT output has one node and one visible edge per tree node and edge
+func TestRenderRandomTrees(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 300 {
+		bt := &BinaryTree{Root: randomTree(rng, 1+rng.Intn(30), 1000)}
+		values := recursiveInOrder(bt.Root, nil)
+
+		var sideways []int
+		for _, line := range strings.Split(strings.TrimSuffix(bt.RenderSideways(), "\n"), "\n") {
+			fields := strings.Fields(strings.NewReplacer("┌──", "", "└──", "", "│", "").Replace(line))
+			v, err := strconv.Atoi(fields[0])
+			if len(fields) != 1 || err != nil {
+				t.Fatalf("RenderSideways(%s) has line %q", bt.FormatParens(), line)
+			}
+			sideways = append(sideways, v)
+		}
+		slices.Reverse(sideways)
+		if !slices.Equal(sideways, values) {
+			t.Fatalf("RenderSideways(%s) lists %v, want %v reversed", bt.FormatParens(), sideways, values)
+		}
+
+		labels := 0
+		for _, line := range strings.Split(strings.TrimSuffix(bt.RenderTopDown(), "\n"), "\n") {
+			if strings.TrimRight(line, " ") != line {
+				t.Fatalf("RenderTopDown(%s) has trailing spaces on %q", bt.FormatParens(), line)
+			}
+			labels += len(strings.Fields(strings.Map(func(r rune) rune {
+				if r == '-' || (r >= '0' && r <= '9') {
+					return r
+				}
+				return ' '
+			}, line)))
+		}
+		if labels != len(values) {
+			t.Fatalf("RenderTopDown(%s) shows %d labels, want %d", bt.FormatParens(), labels, len(values))
+		}
+
+		dot := bt.DOT()
+		if got := strings.Count(dot, "[label="); got != len(values) {
+			t.Fatalf("DOT(%s) has %d nodes, want %d", bt.FormatParens(), got, len(values))
+		}
+		if got := strings.Count(dot, ";\n") - strings.Count(dot, "[style=invis];\n") - strings.Count(dot, "[label=") - 2; got != len(values)-1 {
+			t.Fatalf("DOT(%s) has %d visible edges, want %d", bt.FormatParens(), got, len(values)-1)
+		}
+	}
+}
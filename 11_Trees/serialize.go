@@ -0,0 +1,166 @@
+package tree
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrSyntax is returned when a serialized tree cannot be parsed
+var ErrSyntax = errors.New("tree: syntax error")
+
+func syntaxError(pos int, msg string) error {
+	return fmt.Errorf("%w at offset %d: %s", ErrSyntax, pos, msg)
+}
+
+// FormatLevelOrder returns the tree as a LeetCode-style level-order array
+// such as [1,2,null,3]
+func (bt *BinaryTree) FormatLevelOrder() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, value := range bt.LevelOrderArray() {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		if value == nil {
+			sb.WriteString("null")
+		} else {
+			sb.WriteString(strconv.Itoa(*value))
+		}
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// ParseLevelOrder parses a level-order array written by FormatLevelOrder
+func ParseLevelOrder(s string) (*BinaryTree, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '[' || s[len(s)-1] != ']' {
+		return nil, syntaxError(0, "level-order array must be enclosed in [ ]")
+	}
+	var values []*int
+	if body := strings.TrimSpace(s[1 : len(s)-1]); body != "" {
+		pos := 1
+		for _, field := range strings.Split(s[1:len(s)-1], ",") {
+			switch text := strings.TrimSpace(field); text {
+			case "null":
+				values = append(values, nil)
+			default:
+				value, err := strconv.Atoi(text)
+				if err != nil {
+					return nil, syntaxError(pos, fmt.Sprintf("invalid value %q", text))
+				}
+				values = append(values, &value)
+			}
+			pos += len(field) + 1
+		}
+	}
+	return FromLevelOrder(values)
+}
+
+// FormatParens returns the tree in parenthesized form: a node is written as
+// its value followed by its left and right subtrees in parentheses, an empty
+// left subtree is written as () when there is a right one, and empty trailing
+// subtrees are left out, as in 1(2(4)(5))(3) or 1()(2)
+func (bt *BinaryTree) FormatParens() string {
+	var sb strings.Builder
+	var format func(node *Node)
+	format = func(node *Node) {
+		sb.WriteString(strconv.Itoa(node.Value))
+		if node.Left == nil && node.Right == nil {
+			return
+		}
+		sb.WriteByte('(')
+		if node.Left != nil {
+			format(node.Left)
+		}
+		sb.WriteByte(')')
+		if node.Right != nil {
+			sb.WriteByte('(')
+			format(node.Right)
+			sb.WriteByte(')')
+		}
+	}
+	if bt.Root != nil {
+		format(bt.Root)
+	}
+	return sb.String()
+}
+
+// ParseParens parses the parenthesized form written by FormatParens; an
+// empty string is the empty tree
+func ParseParens(s string) (*BinaryTree, error) {
+	p := &parenParser{input: s}
+	root, err := p.subtree()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(s) {
+		return nil, syntaxError(p.pos, "unexpected trailing input")
+	}
+	return &BinaryTree{Root: root}, nil
+}
+
+type parenParser struct {
+	input string
+	pos   int
+}
+
+func (p *parenParser) peek() byte {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+// subtree parses an optional node: a value with up to two parenthesized
+// children, or nothing before a ')' or the end of input
+func (p *parenParser) subtree() (*Node, error) {
+	if c := p.peek(); c == ')' || c == 0 {
+		return nil, nil
+	}
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		p.pos++
+	}
+	value, err := strconv.Atoi(p.input[start:p.pos])
+	if err != nil {
+		return nil, syntaxError(start, "expected a number")
+	}
+	node := &Node{Value: value}
+	for _, child := range []**Node{&node.Left, &node.Right} {
+		if p.peek() != '(' {
+			break
+		}
+		p.pos++
+		if *child, err = p.subtree(); err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, syntaxError(p.pos, "expected ')'")
+		}
+		p.pos++
+	}
+	return node, nil
+}
+
+// MarshalJSON encodes the tree as nested objects such as
+// {"value":1,"left":{"value":2}}, or null for an empty tree
+func (bt BinaryTree) MarshalJSON() ([]byte, error) {
+	return json.Marshal(bt.Root)
+}
+
+// UnmarshalJSON decodes a tree encoded by MarshalJSON
+func (bt *BinaryTree) UnmarshalJSON(data []byte) error {
+	var root *Node
+	if err := json.Unmarshal(data, &root); err != nil {
+		return err
+	}
+	bt.Root = root
+	return nil
+}
@@ -0,0 +1,108 @@
+package tree
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestSerializeRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 500 {
+		want := &BinaryTree{Root: randomTree(rng, rng.Intn(40), 500)}
+
+		text := want.FormatLevelOrder()
+		got, err := ParseLevelOrder(text)
+		if err != nil || !sameShape(got.Root, want.Root) {
+			t.Fatalf("ParseLevelOrder(%q) did not round-trip: %v", text, err)
+		}
+
+		text = want.FormatParens()
+		got, err = ParseParens(text)
+		if err != nil || !sameShape(got.Root, want.Root) {
+			t.Fatalf("ParseParens(%q) did not round-trip: %v", text, err)
+		}
+
+		data, err := json.Marshal(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded BinaryTree
+		if err := json.Unmarshal(data, &decoded); err != nil || !sameShape(decoded.Root, want.Root) {
+			t.Fatalf("JSON %s did not round-trip: %v", data, err)
+		}
+	}
+}
+
+func TestSerializeForms(t *testing.T) {
+	// 1 has only a right child 2, which has a left child -3
+	bt := &BinaryTree{Root: &Node{Value: 1, Right: &Node{Value: 2, Left: &Node{Value: -3}}}}
+	if got, want := bt.FormatLevelOrder(), "[1,null,2,-3]"; got != want {
+		t.Errorf("FormatLevelOrder() = %s, want %s", got, want)
+	}
+	if got, want := bt.FormatParens(), "1()(2(-3))"; got != want {
+		t.Errorf("FormatParens() = %s, want %s", got, want)
+	}
+	if data, _ := json.Marshal(bt); string(data) != `{"value":1,"right":{"value":2,"left":{"value":-3}}}` {
+		t.Errorf("JSON = %s", data)
+	}
+
+	empty := &BinaryTree{}
+	if got := empty.FormatLevelOrder(); got != "[]" {
+		t.Errorf("empty FormatLevelOrder() = %s, want []", got)
+	}
+	if got := empty.FormatParens(); got != "" {
+		t.Errorf("empty FormatParens() = %q, want empty", got)
+	}
+	if data, _ := json.Marshal(empty); string(data) != "null" {
+		t.Errorf("empty JSON = %s, want null", data)
+	}
+	for _, s := range []string{"[]", " [ ] ", "[null]"} {
+		if bt, err := ParseLevelOrder(s); err != nil || bt.Root != nil {
+			t.Errorf("ParseLevelOrder(%q) = %v, %v; want the empty tree", s, bt, err)
+		}
+	}
+	// spaces around values are accepted
+	if bt, err := ParseLevelOrder("[ 1 , null , 2 ]"); err != nil || bt.FormatParens() != "1()(2)" {
+		t.Errorf("ParseLevelOrder with spaces = %v, %v", bt, err)
+	}
+}
+
+func TestParseRejectsMalformedInput(t *testing.T) {
+	levelOrder := []string{
+		"",
+		"1,2",
+		"[1,2",
+		"[1,,2]",
+		"[1,x]",
+		"[1.5]",
+		"[null,1]",
+		"[1,null,null,2]",
+	}
+	for _, s := range levelOrder {
+		if _, err := ParseLevelOrder(s); !errors.Is(err, ErrSyntax) {
+			t.Errorf("ParseLevelOrder(%q): err = %v, want ErrSyntax", s, err)
+		}
+	}
+	parens := []string{
+		"(1)",
+		"1(2",
+		"1(2))",
+		"1(2)(3)(4)",
+		"-",
+		"1(x)",
+		"1 (2)",
+	}
+	for _, s := range parens {
+		if _, err := ParseParens(s); !errors.Is(err, ErrSyntax) {
+			t.Errorf("ParseParens(%q): err = %v, want ErrSyntax", s, err)
+		}
+	}
+	for _, s := range []string{`{"value":"one"}`, `{"value":1,"left":[]}`, `{`} {
+		var bt BinaryTree
+		if err := json.Unmarshal([]byte(s), &bt); err == nil {
+			t.Errorf("json.Unmarshal(%s) succeeded", s)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package tree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+)
+
+const (
+	skipMaxLevel    = 32
+	skipDefaultProb = 0.5
+)
+
+// SkipList is an ordered map backed by a skip list: a sorted linked list
+// with extra express lanes on top. Each node is promoted to the next lane
+// with probability p, so a search skips ahead on the top lanes and drops
+// down, visiting O(log n) nodes in expectation. Every link also records how
+// many nodes it jumps over, which gives rank and select in O(log n).
+// The zero value is an empty map with p = 0.5 ready to use.
+type SkipList[K cmp.Ordered, V any] struct {
+	head  *skipNode[K, V]
+	level int
+	size  int
+	p     float64
+}
+
+type skipNode[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*skipNode[K, V]
+	span  []int // span[i] is the number of bottom-lane steps next[i] covers
+}
+
+// NewSkipList creates an empty skip list promoting nodes with probability p, which must be in (0, 1)
+func NewSkipList[K cmp.Ordered, V any](p float64) *SkipList[K, V] {
+	if p <= 0 || p >= 1 {
+		panic("tree: skip list probability must be between 0 and 1")
+	}
+	return &SkipList[K, V]{p: p}
+}
+
+func (s *SkipList[K, V]) init() {
+	if s.head == nil {
+		s.head = &skipNode[K, V]{next: make([]*skipNode[K, V], skipMaxLevel), span: make([]int, skipMaxLevel)}
+		s.level = 1
+	}
+	if s.p == 0 {
+		s.p = skipDefaultProb
+	}
+}
+
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < skipMaxLevel && rand.Float64() < s.p {
+		level++
+	}
+	return level
+}
+
+// findLess returns, for every lane, the last node whose key is less than
+// key, and the position of that node counting the head as 0
+func (s *SkipList[K, V]) findLess(key K) ([skipMaxLevel]*skipNode[K, V], [skipMaxLevel]int) {
+	var update [skipMaxLevel]*skipNode[K, V]
+	var rank [skipMaxLevel]int
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && cmp.Less(x.next[i].key, key) {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	return update, rank
+}
+
+// Put stores value under key, replacing any previous value
+func (s *SkipList[K, V]) Put(key K, value V) {
+	s.init()
+	update, rank := s.findLess(key)
+	if next := update[0].next[0]; next != nil && cmp.Compare(next.key, key) == 0 {
+		next.value = value
+		return
+	}
+	level := s.randomLevel()
+	for i := s.level; i < level; i++ {
+		update[i] = s.head
+		rank[i] = 0
+		s.head.span[i] = s.size
+	}
+	s.level = max(s.level, level)
+	n := &skipNode[K, V]{key: key, value: value, next: make([]*skipNode[K, V], level), span: make([]int, level)}
+	for i := range level {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+		// rank[0]-rank[i] is how far the new node is past update[i]
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	for i := level; i < s.level; i++ {
+		update[i].span[i]++
+	}
+	s.size++
+}
+
+// Delete removes key from the map and reports whether it was present
+func (s *SkipList[K, V]) Delete(key K) bool {
+	if s.size == 0 {
+		return false
+	}
+	update, _ := s.findLess(key)
+	x := update[0].next[0]
+	if x == nil || cmp.Compare(x.key, key) != 0 {
+		return false
+	}
+	for i := range s.level {
+		if update[i].next[i] == x {
+			update[i].span[i] += x.span[i] - 1
+			update[i].next[i] = x.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+	return true
+}
+
+// ceiling returns the first node with a key >= key, or nil
+func (s *SkipList[K, V]) ceiling(key K) *skipNode[K, V] {
+	if s.size == 0 {
+		return nil
+	}
+	update, _ := s.findLess(key)
+	return update[0].next[0]
+}
+
+// Get returns the value stored under key and whether it was present
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	if n := s.ceiling(key); n != nil && cmp.Compare(n.key, key) == 0 {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is in the map
+func (s *SkipList[K, V]) Contains(key K) bool {
+	_, ok := s.Get(key)
+	return ok
+}
+
+// Len returns the number of keys in the map
+func (s *SkipList[K, V]) Len() int {
+	return s.size
+}
+
+// Min returns the smallest key
+func (s *SkipList[K, V]) Min() (K, error) {
+	if s.size == 0 {
+		var zero K
+		return zero, ErrEmpty
+	}
+	return s.head.next[0].key, nil
+}
+
+// Max returns the largest key
+func (s *SkipList[K, V]) Max() (K, error) {
+	if s.size == 0 {
+		var zero K
+		return zero, ErrEmpty
+	}
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil {
+			x = x.next[i]
+		}
+	}
+	return x.key, nil
+}
+
+// Floor returns the largest key less than or equal to key
+func (s *SkipList[K, V]) Floor(key K) (K, error) {
+	if s.size > 0 {
+		x := s.head
+		for i := s.level - 1; i >= 0; i-- {
+			for x.next[i] != nil && cmp.Compare(x.next[i].key, key) <= 0 {
+				x = x.next[i]
+			}
+		}
+		if x != s.head {
+			return x.key, nil
+		}
+	}
+	var zero K
+	return zero, ErrNotFound
+}
+
+// Ceiling returns the smallest key greater than or equal to key
+func (s *SkipList[K, V]) Ceiling(key K) (K, error) {
+	if n := s.ceiling(key); n != nil {
+		return n.key, nil
+	}
+	var zero K
+	return zero, ErrNotFound
+}
+
+// Rank returns the number of keys strictly less than key
+func (s *SkipList[K, V]) Rank(key K) int {
+	if s.size == 0 {
+		return 0
+	}
+	_, rank := s.findLess(key)
+	return rank[0]
+}
+
+// Select returns the key of rank k, i.e. the (k+1)-th smallest key
+func (s *SkipList[K, V]) Select(k int) (K, error) {
+	if k < 0 || k >= s.size {
+		var zero K
+		return zero, ErrOutOfRange
+	}
+	x, traversed := s.head, 0
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= k+1 {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+	}
+	return x.key, nil
+}
+
+// Range returns an iterator over the entries with lo <= key <= hi in ascending order
+func (s *SkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.ceiling(lo); n != nil && cmp.Compare(n.key, hi) <= 0; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over all entries in ascending key order
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if s.size == 0 {
+			return
+		}
+		for n := s.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Levels returns the number of lanes currently in use
+func (s *SkipList[K, V]) Levels() int {
+	return s.level
+}
+
+// CheckInvariants verifies that every lane is sorted, that each lane holds
+// exactly the nodes promoted to it, that every span matches the distance on
+// the bottom lane and that the size and level are up to date
+func (s *SkipList[K, V]) CheckInvariants() error {
+	if s.head == nil {
+		if s.size != 0 {
+			return fmt.Errorf("list without a head records size %d", s.size)
+		}
+		return nil
+	}
+	// position of every node on the bottom lane, counting the head as 0
+	pos := map[*skipNode[K, V]]int{s.head: 0}
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		pos[x] = len(pos)
+	}
+	if len(pos)-1 != s.size {
+		return fmt.Errorf("bottom lane holds %d nodes but the list records %d", len(pos)-1, s.size)
+	}
+	for i := range skipMaxLevel {
+		if i >= s.level {
+			if s.head.next[i] != nil {
+				return fmt.Errorf("lane %d is in use above level %d", i, s.level)
+			}
+			continue
+		}
+		if i > 0 && i == s.level-1 && s.head.next[i] == nil {
+			return errors.New("top lane is empty")
+		}
+		promoted := 0
+		for x := s.head.next[0]; x != nil; x = x.next[0] {
+			if len(x.next) > i {
+				promoted++
+			}
+		}
+		onLane := 0
+		for x := s.head; x != nil; x = x.next[i] {
+			next := x.next[i]
+			if x != s.head {
+				onLane++
+			}
+			if next == nil {
+				break
+			}
+			if x != s.head && cmp.Compare(next.key, x.key) <= 0 {
+				return fmt.Errorf("key %v follows %v on lane %d", next.key, x.key, i)
+			}
+			if len(next.next) <= i {
+				return fmt.Errorf("node %v on lane %d has only %d levels", next.key, i, len(next.next))
+			}
+			if x.span[i] != pos[next]-pos[x] {
+				return fmt.Errorf("link from position %d on lane %d spans %d, want %d", pos[x], i, x.span[i], pos[next]-pos[x])
+			}
+		}
+		if onLane != promoted {
+			return fmt.Errorf("lane %d links %d of the %d nodes promoted to it", i, onLane, promoted)
+		}
+	}
+	return nil
+}
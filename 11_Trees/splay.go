@@ -0,0 +1,147 @@
+package tree
+
+import "cmp"
+
+// SplayTree is an ordered map backed by a self-adjusting binary search tree.
+// Every Get, Put and Delete splays the node it touches to the root with a
+// sequence of rotations, so recently used keys stay near the top and any
+// sequence of m operations costs O(m log n) amortized, with no balance
+// information stored. The other order queries walk the tree without
+// restructuring it.
+// The zero value is an empty map ready to use.
+type SplayTree[K cmp.Ordered, V any] struct {
+	orderedQueries[K, V]
+}
+
+func splayRotateRight[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	n.resize()
+	x.resize()
+	return x
+}
+
+func splayRotateLeft[K cmp.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	n.resize()
+	x.resize()
+	return x
+}
+
+// splay brings the node with key to the root of the subtree, or the last
+// node on the search path if key is absent, two levels at a time
+func splay[K cmp.Ordered, V any](n *mapNode[K, V], key K) *mapNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		if n.left == nil {
+			return n
+		}
+		if cmp.Less(key, n.left.key) {
+			n.left.left = splay(n.left.left, key)
+			n = splayRotateRight(n)
+		} else if cmp.Less(n.left.key, key) {
+			n.left.right = splay(n.left.right, key)
+			if n.left.right != nil {
+				n.left = splayRotateLeft(n.left)
+			}
+		}
+		if n.left == nil {
+			return n
+		}
+		return splayRotateRight(n)
+	case c > 0:
+		if n.right == nil {
+			return n
+		}
+		if cmp.Less(n.right.key, key) {
+			n.right.right = splay(n.right.right, key)
+			n = splayRotateLeft(n)
+		} else if cmp.Less(key, n.right.key) {
+			n.right.left = splay(n.right.left, key)
+			if n.right.left != nil {
+				n.right = splayRotateRight(n.right)
+			}
+		}
+		if n.right == nil {
+			return n
+		}
+		return splayRotateLeft(n)
+	}
+	return n
+}
+
+// Get returns the value stored under key and whether it was present
+func (t *SplayTree[K, V]) Get(key K) (V, bool) {
+	t.root = splay(t.root, key)
+	if t.root != nil && cmp.Compare(t.root.key, key) == 0 {
+		return t.root.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is in the map
+func (t *SplayTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Put stores value under key, replacing any previous value. The root after
+// splaying is the neighbour of key, so the new node takes its place and
+// adopts it as a child.
+func (t *SplayTree[K, V]) Put(key K, value V) {
+	t.root = splay(t.root, key)
+	if t.root != nil && cmp.Compare(t.root.key, key) == 0 {
+		t.root.value = value
+		return
+	}
+	n := &mapNode[K, V]{key: key, value: value}
+	if t.root != nil {
+		if cmp.Less(key, t.root.key) {
+			n.left, n.right = t.root.left, t.root
+			t.root.left = nil
+		} else {
+			n.left, n.right = t.root, t.root.right
+			t.root.right = nil
+		}
+		t.root.resize()
+	}
+	n.resize()
+	t.root = n
+}
+
+// Delete removes key from the map and reports whether it was present
+func (t *SplayTree[K, V]) Delete(key K) bool {
+	t.root = splay(t.root, key)
+	if t.root == nil || cmp.Compare(t.root.key, key) != 0 {
+		return false
+	}
+	if t.root.left == nil {
+		t.root = t.root.right
+		return true
+	}
+	// Splaying the left subtree for key brings its largest node to the top,
+	// which has no right child to collide with
+	right := t.root.right
+	t.root = splay(t.root.left, key)
+	t.root.right = right
+	t.root.resize()
+	return true
+}
+
+// Height returns the number of nodes on the longest root-to-leaf path
+func (t *SplayTree[K, V]) Height() int {
+	return treeHeight(t.root)
+}
+
+// CheckInvariants verifies key order and subtree sizes; a splay tree keeps
+// no balance information, so there is nothing else to check
+func (t *SplayTree[K, V]) CheckInvariants() error {
+	return checkOrder(t.root, nil, nil)
+}
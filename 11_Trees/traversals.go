@@ -0,0 +1,230 @@
+package tree
+
+import (
+	"fmt"
+	"iter"
+
+	stackqueue "github.com/kuldeep-bishnoi/Golang-DSA/10_Stacks_Queues"
+)
+
+// InOrderTraversal prints the values in in-order
+func (bt *BinaryTree) InOrderTraversal() {
+	printSeq(bt.InOrder())
+}
+
+// PreOrderTraversal prints the values in pre-order
+func (bt *BinaryTree) PreOrderTraversal() {
+	printSeq(bt.PreOrder())
+}
+
+// PostOrderTraversal prints the values in post-order
+func (bt *BinaryTree) PostOrderTraversal() {
+	printSeq(bt.PostOrder())
+}
+
+func printSeq(values iter.Seq[int]) {
+	for value := range values {
+		fmt.Print(value, " ")
+	}
+	fmt.Println()
+}
+
+// InOrder returns an iterator over the values in in-order (left, root, right).
+// It keeps the path to the current node on an explicit stack, so it works on
+// trees of any depth.
+func (bt *BinaryTree) InOrder() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		inOrderSeq(bt.Root, yield)
+	}
+}
+
+func inOrderSeq(node *Node, yield func(int) bool) {
+	path := &stackqueue.Stack[*Node]{}
+	for node != nil || !path.IsEmpty() {
+		for ; node != nil; node = node.Left {
+			path.Push(node)
+		}
+		node, _ = path.Pop()
+		if !yield(node.Value) {
+			return
+		}
+		node = node.Right
+	}
+}
+
+// PreOrder returns an iterator over the values in pre-order (root, left, right)
+func (bt *BinaryTree) PreOrder() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if bt.Root == nil {
+			return
+		}
+		pending := &stackqueue.Stack[*Node]{}
+		pending.Push(bt.Root)
+		for !pending.IsEmpty() {
+			node, _ := pending.Pop()
+			if !yield(node.Value) {
+				return
+			}
+			// Push right first so the left subtree comes out first
+			if node.Right != nil {
+				pending.Push(node.Right)
+			}
+			if node.Left != nil {
+				pending.Push(node.Left)
+			}
+		}
+	}
+}
+
+// PostOrder returns an iterator over the values in post-order (left, right, root).
+// A node on the path stack is emitted once its right subtree has been visited,
+// which is the case when that subtree is empty or was emitted just before.
+func (bt *BinaryTree) PostOrder() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		path := &stackqueue.Stack[*Node]{}
+		var last *Node
+		node := bt.Root
+		for node != nil || !path.IsEmpty() {
+			for ; node != nil; node = node.Left {
+				path.Push(node)
+			}
+			top, _ := path.Peek()
+			if top.Right != nil && top.Right != last {
+				node = top.Right
+				continue
+			}
+			path.Pop()
+			if !yield(top.Value) {
+				return
+			}
+			last = top
+		}
+	}
+}
+
+// MorrisInOrder returns the values in in-order using O(1) extra space.
+// Instead of a stack it threads the rightmost node of each left subtree back
+// to its in-order successor, follows the thread to climb back up and removes
+// it on the second visit, leaving the tree unchanged.
+func (bt *BinaryTree) MorrisInOrder() []int {
+	var values []int
+	node := bt.Root
+	for node != nil {
+		if node.Left == nil {
+			values = append(values, node.Value)
+			node = node.Right
+			continue
+		}
+		pred := rightmostBelow(node)
+		if pred.Right == nil {
+			pred.Right = node
+			node = node.Left
+		} else {
+			pred.Right = nil
+			values = append(values, node.Value)
+			node = node.Right
+		}
+	}
+	return values
+}
+
+// MorrisPreOrder returns the values in pre-order using O(1) extra space,
+// emitting each node when its thread is created rather than when it is removed
+func (bt *BinaryTree) MorrisPreOrder() []int {
+	var values []int
+	node := bt.Root
+	for node != nil {
+		if node.Left == nil {
+			values = append(values, node.Value)
+			node = node.Right
+			continue
+		}
+		pred := rightmostBelow(node)
+		if pred.Right == nil {
+			values = append(values, node.Value)
+			pred.Right = node
+			node = node.Left
+		} else {
+			pred.Right = nil
+			node = node.Right
+		}
+	}
+	return values
+}
+
+// MorrisPostOrder returns the values in post-order using O(1) extra space.
+// When a thread is removed, the right edge of the left subtree it closes is
+// emitted bottom-up by reversing it in place and reversing it back.
+func (bt *BinaryTree) MorrisPostOrder() []int {
+	var values []int
+	dummy := &Node{Left: bt.Root}
+	node := dummy
+	for node != nil {
+		if node.Left == nil {
+			node = node.Right
+			continue
+		}
+		pred := rightmostBelow(node)
+		if pred.Right == nil {
+			pred.Right = node
+			node = node.Left
+			continue
+		}
+		pred.Right = nil
+		reverseRightEdge(node.Left)
+		for n := pred; n != nil; n = n.Right {
+			values = append(values, n.Value)
+		}
+		reverseRightEdge(pred)
+		node = node.Right
+	}
+	return values
+}
+
+// rightmostBelow returns the in-order predecessor of node, the rightmost node
+// of its left subtree, stopping at a thread that already points back to node
+func rightmostBelow(node *Node) *Node {
+	pred := node.Left
+	for pred.Right != nil && pred.Right != node {
+		pred = pred.Right
+	}
+	return pred
+}
+
+// reverseRightEdge reverses the chain of right pointers starting at node
+func reverseRightEdge(node *Node) {
+	var prev *Node
+	for node != nil {
+		next := node.Right
+		node.Right = prev
+		prev, node = node, next
+	}
+}
+
+// LevelOrder returns an iterator over the values level by level, left to right
+func (bt *BinaryTree) LevelOrder() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if bt.Root == nil {
+			return
+		}
+		queue := &stackqueue.Queue[*Node]{}
+		queue.Enqueue(bt.Root)
+		for !queue.IsEmpty() {
+			node, _ := queue.Dequeue()
+			if !yield(node.Value) {
+				return
+			}
+			if node.Left != nil {
+				queue.Enqueue(node.Left)
+			}
+			if node.Right != nil {
+				queue.Enqueue(node.Right)
+			}
+		}
+	}
+}
+
+// All returns an iterator over the values in in-order
+func (bt *BinaryTree) All() iter.Seq[int] {
+	return bt.InOrder()
+}
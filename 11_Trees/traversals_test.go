@@ -0,0 +1,223 @@
+package tree
+
+import (
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+)
+
+// links records the children of every node, so a test can tell whether a
+// traversal left the tree exactly as it found it
+func links(node *Node, into map[*Node][2]*Node) map[*Node][2]*Node {
+	if node != nil {
+		into[node] = [2]*Node{node.Left, node.Right}
+		links(node.Left, into)
+		links(node.Right, into)
+	}
+	return into
+}
+
+func TestTraversalsAgainstRecursion(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 500 {
+		bt := &BinaryTree{Root: randomTree(rng, rng.Intn(60), 100)}
+		before := links(bt.Root, map[*Node][2]*Node{})
+		in := recursiveInOrder(bt.Root, nil)
+		pre := recursivePreOrder(bt.Root, nil)
+		post := recursivePostOrder(bt.Root, nil)
+		var levels []int
+		for _, level := range recursiveLevels(bt.Root, 0, nil) {
+			levels = append(levels, level...)
+		}
+		tests := []struct {
+			name      string
+			got, want []int
+		}{
+			{"InOrder", slices.Collect(bt.InOrder()), in},
+			{"All", slices.Collect(bt.All()), in},
+			{"PreOrder", slices.Collect(bt.PreOrder()), pre},
+			{"PostOrder", slices.Collect(bt.PostOrder()), post},
+			{"LevelOrder", slices.Collect(bt.LevelOrder()), levels},
+			{"MorrisInOrder", bt.MorrisInOrder(), in},
+			{"MorrisPreOrder", bt.MorrisPreOrder(), pre},
+			{"MorrisPostOrder", bt.MorrisPostOrder(), post},
+		}
+		for _, tt := range tests {
+			if !slices.Equal(tt.got, tt.want) {
+				t.Fatalf("%s of %s = %v, want %v", tt.name, bt.FormatParens(), tt.got, tt.want)
+			}
+		}
+		// the Morris traversals thread and unthread the tree and must
+		// restore every link, including the reversed right edges
+		after := links(bt.Root, map[*Node][2]*Node{})
+		if len(after) != len(before) {
+			t.Fatalf("tree has %d nodes after the Morris traversals, want %d", len(after), len(before))
+		}
+		for node, children := range before {
+			if after[node] != children {
+				t.Fatalf("Morris traversals changed the children of %d in %s", node.Value, bt.FormatParens())
+			}
+		}
+	}
+}
+
+func TestBinarySearchTreeAll(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for n := range 50 {
+		values := rng.Perm(n)
+		bst := &BinarySearchTree{}
+		for _, v := range values {
+			bst.Insert(v)
+		}
+		slices.Sort(values)
+		if got := slices.Collect(bst.All()); !slices.Equal(got, values) {
+			t.Fatalf("All() = %v, want %v", got, values)
+		}
+	}
+}
+
+// TestTraversalsStopEarly breaks out of each iterator; the range loop panics
+// if an iterator keeps calling yield after the body has stopped it
+func TestTraversalsStopEarly(t *testing.T) {
+	bt := mustParens(t, "1(2(4)(5))(3(6)(7))")
+	bst := &BinarySearchTree{Root: mustParens(t, "4(2(1)(3))(6(5)(7))").Root}
+	for name, seq := range map[string]func(func(int) bool){
+		"InOrder": bt.InOrder(), "PreOrder": bt.PreOrder(), "PostOrder": bt.PostOrder(), "LevelOrder": bt.LevelOrder(),
+		"All": bt.All(), "BinarySearchTree.All": bst.All(),
+	} {
+		var got []int
+		for v := range seq {
+			got = append(got, v)
+			if len(got) == 3 {
+				break
+			}
+		}
+		if len(got) != 3 {
+			t.Errorf("%s yielded %v after a break at 3 values", name, got)
+		}
+	}
+}
+
+// recursiveLevels groups values by depth with a pre-order walk, which
+// visits every level left to right
+func recursiveLevels(node *Node, depth int, levels [][]int) [][]int {
+	if node == nil {
+		return levels
+	}
+	if depth == len(levels) {
+		levels = append(levels, nil)
+	}
+	levels[depth] = append(levels[depth], node.Value)
+	levels = recursiveLevels(node.Left, depth+1, levels)
+	return recursiveLevels(node.Right, depth+1, levels)
+}
+
+// recursiveColumns groups values by column, ordered by depth and, within a
+// depth, left to right
+func recursiveColumns(root *Node) [][]int {
+	type placed struct{ value, column, depth int }
+	var all []placed
+	var walk func(node *Node, column, depth int)
+	walk = func(node *Node, column, depth int) {
+		if node != nil {
+			all = append(all, placed{node.Value, column, depth})
+			walk(node.Left, column-1, depth+1)
+			walk(node.Right, column+1, depth+1)
+		}
+	}
+	walk(root, 0, 0)
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].column != all[j].column {
+			return all[i].column < all[j].column
+		}
+		return all[i].depth < all[j].depth
+	})
+	var columns [][]int
+	for i, p := range all {
+		if i == 0 || p.column != all[i-1].column {
+			columns = append(columns, nil)
+		}
+		columns[len(columns)-1] = append(columns[len(columns)-1], p.value)
+	}
+	return columns
+}
+
+func equalGroups(a, b [][]int) bool {
+	return slices.EqualFunc(a, b, func(x, y []int) bool { return slices.Equal(x, y) })
+}
+
+func TestLevelTraversalsAgainstRecursion(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 500 {
+		bt := &BinaryTree{Root: randomTree(rng, rng.Intn(60), 100)}
+		levels := recursiveLevels(bt.Root, 0, nil)
+		if got := bt.Levels(); !equalGroups(got, levels) {
+			t.Fatalf("Levels of %s = %v, want %v", bt.FormatParens(), got, levels)
+		}
+		if got, want := slices.Collect(bt.LevelOrder()), slices.Concat(levels...); !slices.Equal(got, want) {
+			t.Fatalf("LevelOrder of %s = %v, want %v", bt.FormatParens(), got, want)
+		}
+		zigzag := make([][]int, len(levels))
+		for depth, level := range levels {
+			zigzag[depth] = slices.Clone(level)
+			if depth%2 == 1 {
+				slices.Reverse(zigzag[depth])
+			}
+		}
+		if got := bt.ZigzagLevelOrder(); !equalGroups(got, zigzag) {
+			t.Fatalf("ZigzagLevelOrder of %s = %v, want %v", bt.FormatParens(), got, zigzag)
+		}
+		reversed := slices.Clone(levels)
+		slices.Reverse(reversed)
+		if got := bt.ReverseLevelOrder(); !equalGroups(got, reversed) {
+			t.Fatalf("ReverseLevelOrder of %s = %v, want %v", bt.FormatParens(), got, reversed)
+		}
+		if got, want := bt.VerticalOrder(), recursiveColumns(bt.Root); !equalGroups(got, want) {
+			t.Fatalf("VerticalOrder of %s = %v, want %v", bt.FormatParens(), got, want)
+		}
+	}
+}
+
+func TestLevelTraversalExamples(t *testing.T) {
+	tests := []struct {
+		name string
+		tree string
+		got  func(bt *BinaryTree) [][]int
+		want [][]int
+	}{
+		{"ZigzagLevelOrder", "3(9)(20(15)(7))", (*BinaryTree).ZigzagLevelOrder, [][]int{{3}, {20, 9}, {15, 7}}},
+		{"VerticalOrder", "3(9)(20(15)(7))", (*BinaryTree).VerticalOrder, [][]int{{9}, {3, 15}, {20}, {7}}},
+		// 5 and 6 share column 0 and depth 2, so they stay left to right
+		{"VerticalOrder with a shared cell", "1(2(4)(6))(3(5)(7))", (*BinaryTree).VerticalOrder, [][]int{{4}, {2}, {1, 6, 5}, {3}, {7}}},
+		{"Levels of the empty tree", "", (*BinaryTree).Levels, nil},
+	}
+	for _, tt := range tests {
+		if got := tt.got(mustParens(t, tt.tree)); !equalGroups(got, tt.want) {
+			t.Errorf("%s(%s) = %v, want %v", tt.name, tt.tree, got, tt.want)
+		}
+	}
+}
+
+func TestBoundaryTraversal(t *testing.T) {
+	tests := []struct {
+		tree string
+		want []int
+	}{
+		{"", nil},
+		{"1", []int{1}},
+		{"1(2(4)(5(8)(9)))(3(6)(7))", []int{1, 2, 4, 8, 9, 6, 7, 3}},
+		// no left subtree: the boundary goes straight to the leaves
+		{"1()(2(3)(4))", []int{1, 3, 4, 2}},
+		// a left chain is all boundary, its last node is the only leaf
+		{"1(2(3(4)))", []int{1, 2, 3, 4}},
+		// the left boundary steps right when a node has no left child
+		{"1(2()(3(4)))(5)", []int{1, 2, 3, 4, 5}},
+		{"1(2)(3()(4(5)))", []int{1, 2, 5, 4, 3}},
+	}
+	for _, tt := range tests {
+		if got := mustParens(t, tt.tree).BoundaryTraversal(); !slices.Equal(got, tt.want) {
+			t.Errorf("BoundaryTraversal(%s) = %v, want %v", tt.tree, got, tt.want)
+		}
+	}
+}
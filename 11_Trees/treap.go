@@ -0,0 +1,141 @@
+package tree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+)
+
+// ErrKeyOrder is returned by Treap.Merge when the keys of the two treaps overlap
+var ErrKeyOrder = errors.New("tree: merged treap keys must all be greater")
+
+// Treap is an ordered map backed by a randomized binary search tree: every
+// node gets a random priority and the tree is kept heap-ordered on the
+// priorities, which makes its shape that of a BST built from a random
+// insertion order, with expected O(log n) depth whatever order keys arrive in.
+// All updates are built from two primitives, split and merge.
+// The zero value is an empty map ready to use.
+type Treap[K cmp.Ordered, V any] struct {
+	orderedQueries[K, V]
+}
+
+// treapSplit splits the subtree rooted at n into the keys less than key and
+// the keys greater than or equal to key
+func treapSplit[K cmp.Ordered, V any](n *mapNode[K, V], key K) (*mapNode[K, V], *mapNode[K, V]) {
+	if n == nil {
+		return nil, nil
+	}
+	if cmp.Less(n.key, key) {
+		left, right := treapSplit(n.right, key)
+		n.right = left
+		n.resize()
+		return n, right
+	}
+	left, right := treapSplit(n.left, key)
+	n.left = right
+	n.resize()
+	return left, n
+}
+
+// treapMerge joins two treaps where every key of a is less than every key
+// of b, keeping the root with the higher priority on top
+func treapMerge[K cmp.Ordered, V any](a, b *mapNode[K, V]) *mapNode[K, V] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		a.right = treapMerge(a.right, b)
+		a.resize()
+		return a
+	}
+	b.left = treapMerge(a, b.left)
+	b.resize()
+	return b
+}
+
+// Put stores value under key, replacing any previous value
+func (t *Treap[K, V]) Put(key K, value V) {
+	if n := getNode(t.root, key); n != nil {
+		n.value = value
+		return
+	}
+	n := &mapNode[K, V]{key: key, value: value, size: 1, priority: rand.Uint32()}
+	left, right := treapSplit(t.root, key)
+	t.root = treapMerge(treapMerge(left, n), right)
+}
+
+// Delete removes key from the map and reports whether it was present
+func (t *Treap[K, V]) Delete(key K) bool {
+	if getNode(t.root, key) == nil {
+		return false
+	}
+	t.root = treapDelete(t.root, key)
+	return true
+}
+
+// treapDelete removes key from the subtree rooted at n, which must contain
+// it, by merging the two subtrees of its node
+func treapDelete[K cmp.Ordered, V any](n *mapNode[K, V], key K) *mapNode[K, V] {
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = treapDelete(n.left, key)
+	case c > 0:
+		n.right = treapDelete(n.right, key)
+	default:
+		return treapMerge(n.left, n.right)
+	}
+	n.resize()
+	return n
+}
+
+// Split moves every key greater than or equal to key into a new treap,
+// which it returns, in expected O(log n) time
+func (t *Treap[K, V]) Split(key K) *Treap[K, V] {
+	other := &Treap[K, V]{}
+	t.root, other.root = treapSplit(t.root, key)
+	return other
+}
+
+// Merge moves every entry of other into t in expected O(log n) time. All
+// keys of other must be greater than all keys of t; other is left empty.
+func (t *Treap[K, V]) Merge(other *Treap[K, V]) error {
+	if t.root != nil && other.root != nil && cmp.Compare(maxNode(t.root).key, minNode(other.root).key) >= 0 {
+		return ErrKeyOrder
+	}
+	t.root = treapMerge(t.root, other.root)
+	other.root = nil
+	return nil
+}
+
+// Height returns the number of nodes on the longest root-to-leaf path
+func (t *Treap[K, V]) Height() int {
+	return treeHeight(t.root)
+}
+
+// CheckInvariants verifies key order, subtree sizes and that no node has a
+// higher priority than its parent
+func (t *Treap[K, V]) CheckInvariants() error {
+	if err := checkOrder(t.root, nil, nil); err != nil {
+		return err
+	}
+	return checkHeapOrder(t.root)
+}
+
+func checkHeapOrder[K cmp.Ordered, V any](n *mapNode[K, V]) error {
+	if n == nil {
+		return nil
+	}
+	for _, child := range []*mapNode[K, V]{n.left, n.right} {
+		if child != nil && child.priority > n.priority {
+			return fmt.Errorf("node %v outranks its parent %v", child.key, n.key)
+		}
+	}
+	if err := checkHeapOrder(n.left); err != nil {
+		return err
+	}
+	return checkHeapOrder(n.right)
+}
@@ -0,0 +1,263 @@
+package trie
+
+import (
+	"iter"
+	"slices"
+	"strings"
+)
+
+// RadixTree is a compressed trie: chains of nodes with a single child and no
+// key are collapsed into one edge labelled with a whole substring. A tree of
+// n keys has fewer than 2n nodes regardless of key length, so it uses far
+// less memory than Trie on long keys with sparse branching. Every node other
+// than the root either holds a key or has at least two children. The zero
+// value is an empty tree.
+type RadixTree[V any] struct {
+	root radixNode[V]
+	size int
+}
+
+type radixNode[V any] struct {
+	prefix   string          // label of the edge leading into this node
+	children []*radixNode[V] // sorted by the first byte of their prefix
+	value    V
+	ok       bool // a key ends at this node
+}
+
+// search returns the position of the child whose prefix starts with b
+func (n *radixNode[V]) search(b byte) (int, bool) {
+	return slices.BinarySearchFunc(n.children, b, func(c *radixNode[V], b byte) int {
+		return int(c.prefix[0]) - int(b)
+	})
+}
+
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// Insert adds a key or replaces the value stored under it
+func (r *RadixTree[V]) Insert(key string, value V) {
+	n, rest := &r.root, key
+	for rest != "" {
+		i, found := n.search(rest[0])
+		if !found {
+			n.children = slices.Insert(n.children, i, &radixNode[V]{prefix: rest})
+			n = n.children[i]
+			break
+		}
+		c := n.children[i]
+		common := commonPrefix(c.prefix, rest)
+		if common < len(c.prefix) {
+			// split the edge where the key leaves it
+			mid := &radixNode[V]{prefix: c.prefix[:common], children: []*radixNode[V]{c}}
+			c.prefix = c.prefix[common:]
+			n.children[i] = mid
+			c = mid
+		}
+		n, rest = c, rest[common:]
+	}
+	if !n.ok {
+		r.size++
+	}
+	n.value, n.ok = value, true
+}
+
+// find returns the node holding exactly key, or nil
+func (r *RadixTree[V]) find(key string) *radixNode[V] {
+	n, rest := &r.root, key
+	for rest != "" {
+		i, found := n.search(rest[0])
+		if !found || !strings.HasPrefix(rest, n.children[i].prefix) {
+			return nil
+		}
+		n = n.children[i]
+		rest = rest[len(n.prefix):]
+	}
+	return n
+}
+
+// Get returns the value stored under key
+func (r *RadixTree[V]) Get(key string) (V, bool) {
+	if n := r.find(key); n != nil && n.ok {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes a key, dropping its node if it is a leaf and merging nodes
+// left with a single child into that child. It reports whether the key was
+// present.
+func (r *RadixTree[V]) Delete(key string) bool {
+	var parent *radixNode[V]
+	n, rest := &r.root, key
+	for rest != "" {
+		i, found := n.search(rest[0])
+		if !found || !strings.HasPrefix(rest, n.children[i].prefix) {
+			return false
+		}
+		parent, n = n, n.children[i]
+		rest = rest[len(n.prefix):]
+	}
+	if !n.ok {
+		return false
+	}
+	var zero V
+	n.value, n.ok = zero, false
+	r.size--
+	if parent == nil {
+		return true
+	}
+	switch len(n.children) {
+	case 0:
+		i, _ := parent.search(n.prefix[0])
+		parent.children = slices.Delete(parent.children, i, i+1)
+		if parent != &r.root && !parent.ok && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		n.mergeChild()
+	}
+	return true
+}
+
+// mergeChild absorbs the only child of n, which holds no key
+func (n *radixNode[V]) mergeChild() {
+	c := n.children[0]
+	n.prefix += c.prefix
+	n.children, n.value, n.ok = c.children, c.value, c.ok
+}
+
+// Len returns the number of keys
+func (r *RadixTree[V]) Len() int {
+	return r.size
+}
+
+// locate returns the highest node whose keys all start with prefix, together
+// with the full path to it, which may extend past prefix when prefix ends in
+// the middle of an edge
+func (r *RadixTree[V]) locate(prefix string) (*radixNode[V], string) {
+	n, rest := &r.root, prefix
+	for rest != "" {
+		i, found := n.search(rest[0])
+		if !found {
+			return nil, ""
+		}
+		n = n.children[i]
+		if strings.HasPrefix(n.prefix, rest) {
+			return n, prefix + n.prefix[len(rest):]
+		}
+		if !strings.HasPrefix(rest, n.prefix) {
+			return nil, ""
+		}
+		rest = rest[len(n.prefix):]
+	}
+	return n, prefix
+}
+
+// HasPrefix reports whether any key starts with prefix
+func (r *RadixTree[V]) HasPrefix(prefix string) bool {
+	n, _ := r.locate(prefix)
+	return n != nil && (n.ok || len(n.children) > 0)
+}
+
+// KeysWithPrefix returns an iterator over the keys starting with prefix and
+// their values, in lexicographic order
+func (r *RadixTree[V]) KeysWithPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		if n, path := r.locate(prefix); n != nil {
+			n.walk([]byte(path), yield)
+		}
+	}
+}
+
+// All returns an iterator over all keys and values in lexicographic order
+func (r *RadixTree[V]) All() iter.Seq2[string, V] {
+	return r.KeysWithPrefix("")
+}
+
+// walk yields the keys below n, with key holding the path to n
+func (n *radixNode[V]) walk(key []byte, yield func(string, V) bool) bool {
+	if n.ok && !yield(string(key), n.value) {
+		return false
+	}
+	for _, c := range n.children {
+		if !c.walk(append(key, c.prefix...), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// LongestPrefixOf returns the longest key that is a prefix of s
+func (r *RadixTree[V]) LongestPrefixOf(s string) (string, V, bool) {
+	var found *radixNode[V]
+	best := -1
+	n, depth := &r.root, 0
+	for {
+		if n.ok {
+			best, found = depth, n
+		}
+		if depth == len(s) {
+			break
+		}
+		i, ok := n.search(s[depth])
+		if !ok || !strings.HasPrefix(s[depth:], n.children[i].prefix) {
+			break
+		}
+		n = n.children[i]
+		depth += len(n.prefix)
+	}
+	if best < 0 {
+		var zero V
+		return "", zero, false
+	}
+	return s[:best], found.value, true
+}
+
+// Match returns an iterator over the keys matching pattern, where Wildcard
+// matches any single byte and every other byte matches itself, in
+// lexicographic order
+func (r *RadixTree[V]) Match(pattern string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		r.root.match(pattern, make([]byte, 0, len(pattern)), yield)
+	}
+}
+
+func (n *radixNode[V]) match(pattern string, key []byte, yield func(string, V) bool) bool {
+	if len(key) == len(pattern) {
+		return !n.ok || yield(string(key), n.value)
+	}
+	if b := pattern[len(key)]; b != Wildcard {
+		// only one child can start with a literal byte
+		i, found := n.search(b)
+		if !found {
+			return true
+		}
+		return n.children[i].matchEdge(pattern, key, yield)
+	}
+	for _, c := range n.children {
+		if !c.matchEdge(pattern, key, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchEdge checks the label of n against the pattern before descending
+func (n *radixNode[V]) matchEdge(pattern string, key []byte, yield func(string, V) bool) bool {
+	rest := pattern[len(key):]
+	if len(n.prefix) > len(rest) {
+		return true
+	}
+	for i := 0; i < len(n.prefix); i++ {
+		if rest[i] != Wildcard && rest[i] != n.prefix[i] {
+			return true
+		}
+	}
+	return n.match(pattern, append(key, n.prefix...), yield)
+}
@@ -0,0 +1,201 @@
+package trie
+
+import (
+	"iter"
+	"slices"
+)
+
+// Wildcard matches any single byte in a pattern passed to Match
+const Wildcard = '.'
+
+// Map is a string-keyed map that also answers prefix queries. Keys are
+// treated as byte strings, so iteration is in lexicographic byte order and a
+// Wildcard stands for exactly one byte.
+type Map[V any] interface {
+	Insert(key string, value V)
+	Get(key string) (V, bool)
+	Delete(key string) bool
+	Len() int
+	HasPrefix(prefix string) bool
+	KeysWithPrefix(prefix string) iter.Seq2[string, V]
+	LongestPrefixOf(s string) (string, V, bool)
+	Match(pattern string) iter.Seq2[string, V]
+	All() iter.Seq2[string, V]
+}
+
+var (
+	_ Map[int] = (*Trie[int])(nil)
+	_ Map[int] = (*RadixTree[int])(nil)
+)
+
+// Trie stores one node per byte of every key. Children are kept in slices
+// sorted by their byte label instead of a 256-entry array or a map, which
+// keeps sparse nodes small and makes in-order iteration free. The zero value
+// is an empty trie.
+type Trie[V any] struct {
+	root trieNode[V]
+	size int
+}
+
+type trieNode[V any] struct {
+	labels   []byte
+	children []*trieNode[V]
+	value    V
+	ok       bool // a key ends at this node
+}
+
+func (n *trieNode[V]) child(b byte) *trieNode[V] {
+	if i, found := slices.BinarySearch(n.labels, b); found {
+		return n.children[i]
+	}
+	return nil
+}
+
+// find returns the node reached by following key, or nil
+func (t *Trie[V]) find(key string) *trieNode[V] {
+	n := &t.root
+	for i := 0; i < len(key) && n != nil; i++ {
+		n = n.child(key[i])
+	}
+	return n
+}
+
+// Insert adds a key or replaces the value stored under it
+func (t *Trie[V]) Insert(key string, value V) {
+	n := &t.root
+	for i := 0; i < len(key); i++ {
+		j, found := slices.BinarySearch(n.labels, key[i])
+		if !found {
+			n.labels = slices.Insert(n.labels, j, key[i])
+			n.children = slices.Insert(n.children, j, &trieNode[V]{})
+		}
+		n = n.children[j]
+	}
+	if !n.ok {
+		t.size++
+	}
+	n.value, n.ok = value, true
+}
+
+// Get returns the value stored under key
+func (t *Trie[V]) Get(key string) (V, bool) {
+	if n := t.find(key); n != nil && n.ok {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes a key and prunes the nodes that no longer lead to any key.
+// It reports whether the key was present.
+func (t *Trie[V]) Delete(key string) bool {
+	path := make([]*trieNode[V], 0, len(key)+1)
+	n := &t.root
+	path = append(path, n)
+	for i := 0; i < len(key); i++ {
+		if n = n.child(key[i]); n == nil {
+			return false
+		}
+		path = append(path, n)
+	}
+	if !n.ok {
+		return false
+	}
+	var zero V
+	n.value, n.ok = zero, false
+	t.size--
+	for i := len(key); i > 0 && !path[i].ok && len(path[i].children) == 0; i-- {
+		parent := path[i-1]
+		j, _ := slices.BinarySearch(parent.labels, key[i-1])
+		parent.labels = slices.Delete(parent.labels, j, j+1)
+		parent.children = slices.Delete(parent.children, j, j+1)
+	}
+	return true
+}
+
+// Len returns the number of keys
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+// HasPrefix reports whether any key starts with prefix
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	// Delete prunes dead branches, so every node other than the root leads
+	// to at least one key
+	n := t.find(prefix)
+	return n != nil && (n.ok || len(n.children) > 0)
+}
+
+// KeysWithPrefix returns an iterator over the keys starting with prefix and
+// their values, in lexicographic order
+func (t *Trie[V]) KeysWithPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		if n := t.find(prefix); n != nil {
+			n.walk([]byte(prefix), yield)
+		}
+	}
+}
+
+// All returns an iterator over all keys and values in lexicographic order
+func (t *Trie[V]) All() iter.Seq2[string, V] {
+	return t.KeysWithPrefix("")
+}
+
+// walk yields the keys below n, with key holding the bytes leading to n
+func (n *trieNode[V]) walk(key []byte, yield func(string, V) bool) bool {
+	if n.ok && !yield(string(key), n.value) {
+		return false
+	}
+	for i, c := range n.children {
+		if !c.walk(append(key, n.labels[i]), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// LongestPrefixOf returns the longest key that is a prefix of s
+func (t *Trie[V]) LongestPrefixOf(s string) (string, V, bool) {
+	best, found := -1, &t.root
+	n := &t.root
+	for i := 0; n != nil; i++ {
+		if n.ok {
+			best, found = i, n
+		}
+		if i == len(s) {
+			break
+		}
+		n = n.child(s[i])
+	}
+	if best < 0 {
+		var zero V
+		return "", zero, false
+	}
+	return s[:best], found.value, true
+}
+
+// Match returns an iterator over the keys matching pattern, where Wildcard
+// matches any single byte and every other byte matches itself, in
+// lexicographic order
+func (t *Trie[V]) Match(pattern string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		t.root.match(pattern, make([]byte, 0, len(pattern)), yield)
+	}
+}
+
+func (n *trieNode[V]) match(pattern string, key []byte, yield func(string, V) bool) bool {
+	if len(key) == len(pattern) {
+		return !n.ok || yield(string(key), n.value)
+	}
+	b := pattern[len(key)]
+	if b != Wildcard {
+		c := n.child(b)
+		return c == nil || c.match(pattern, append(key, b), yield)
+	}
+	for i, c := range n.children {
+		if !c.match(pattern, append(key, n.labels[i]), yield) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,313 @@
+package trie
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var prefixMaps = []struct {
+	name string
+	make func() Map[int]
+}{
+	{"trie", func() Map[int] { return &Trie[int]{} }},
+	{"radix", func() Map[int] { return &RadixTree[int]{} }},
+}
+
+// randomKey returns a short key over a small alphabet, so keys share long
+// prefixes and often are prefixes of each other; the empty key is included
+func randomKey(rng *rand.Rand, alphabet string) string {
+	b := make([]byte, rng.Intn(7))
+	for i := range b {
+		b[i] = alphabet[rng.Intn(len(alphabet))]
+	}
+	return string(b)
+}
+
+// collect gathers the keys an iterator yields, checking each value against want
+func collect(t *testing.T, what string, seq func(func(string, int) bool), want map[string]int) []string {
+	t.Helper()
+	var keys []string
+	for key, value := range seq {
+		if value != want[key] {
+			t.Fatalf("%s yielded %q=%d, want %d", what, key, value, want[key])
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// matches is the oracle for Match
+func matches(pattern, key string) bool {
+	if len(pattern) != len(key) {
+		return false
+	}
+	for i := range len(key) {
+		if pattern[i] != Wildcard && pattern[i] != key[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// compareWithMap checks every query of m against the oracle map, probing
+// with queries built from the same alphabet as the keys
+func compareWithMap(t *testing.T, m Map[int], oracle map[string]int, rng *rand.Rand) {
+	t.Helper()
+	sorted := slices.Sorted(maps.Keys(oracle))
+	if m.Len() != len(oracle) {
+		t.Fatalf("Len() = %d, want %d", m.Len(), len(oracle))
+	}
+	if got := collect(t, "All()", m.All(), oracle); !slices.Equal(got, sorted) {
+		t.Fatalf("All() = %q, want %q", got, sorted)
+	}
+
+	probe := randomKey(rng, "abc")
+	want, wantOK := oracle[probe]
+	if got, ok := m.Get(probe); got != want || ok != wantOK {
+		t.Fatalf("Get(%q) = %d, %v; want %d, %v", probe, got, ok, want, wantOK)
+	}
+
+	var withPrefix []string
+	for _, key := range sorted {
+		if strings.HasPrefix(key, probe) {
+			withPrefix = append(withPrefix, key)
+		}
+	}
+	if got := m.HasPrefix(probe); got != (len(withPrefix) > 0) {
+		t.Fatalf("HasPrefix(%q) = %v, want %v", probe, got, !got)
+	}
+	if got := collect(t, "KeysWithPrefix", m.KeysWithPrefix(probe), oracle); !slices.Equal(got, withPrefix) {
+		t.Fatalf("KeysWithPrefix(%q) = %q, want %q", probe, got, withPrefix)
+	}
+
+	wantKey, wantOK := "", false
+	for _, key := range sorted {
+		if strings.HasPrefix(probe, key) && (!wantOK || len(key) > len(wantKey)) {
+			wantKey, wantOK = key, true
+		}
+	}
+	if key, value, ok := m.LongestPrefixOf(probe); key != wantKey || ok != wantOK || value != oracle[wantKey] {
+		t.Fatalf("LongestPrefixOf(%q) = %q, %d, %v; want %q, %v", probe, key, value, ok, wantKey, wantOK)
+	}
+
+	pattern := []byte(randomKey(rng, "ab.."))
+	var wantMatches []string
+	for _, key := range sorted {
+		if matches(string(pattern), key) {
+			wantMatches = append(wantMatches, key)
+		}
+	}
+	if got := collect(t, "Match", m.Match(string(pattern)), oracle); !slices.Equal(got, wantMatches) {
+		t.Fatalf("Match(%q) = %q, want %q", pattern, got, wantMatches)
+	}
+}
+
+func hasKey(m map[string]int, key string) bool {
+	_, ok := m[key]
+	return ok
+}
+
+// checkRadix verifies the shape of a radix tree: children sorted by a
+// distinct first byte, no empty edge labels, and no node other than the
+// root that could be merged with its only child or dropped as a keyless leaf
+func checkRadix(n *radixNode[int], root bool) error {
+	if !root {
+		if n.prefix == "" {
+			return errors.New("node below the root has an empty edge label")
+		}
+		if !n.ok && len(n.children) < 2 {
+			return fmt.Errorf("keyless node %q has %d children", n.prefix, len(n.children))
+		}
+	}
+	for i, c := range n.children {
+		if i > 0 && n.children[i-1].prefix[0] >= c.prefix[0] {
+			return fmt.Errorf("children %q and %q are out of order", n.children[i-1].prefix, c.prefix)
+		}
+		if err := checkRadix(c, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TestMapsAgainstOracle applies random inserts and deletes to both
+// structures and a built-in map and compares every query after each step
+func TestMapsAgainstOracle(t *testing.T) {
+	for _, tt := range prefixMaps {
+		t.Run(tt.name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(1))
+			m := tt.make()
+			oracle := map[string]int{}
+			for step := range 4000 {
+				key := randomKey(rng, "abc")
+				// drain the structure for the last quarter of the run
+				if step < 3000 && rng.Intn(3) > 0 {
+					m.Insert(key, step+1)
+					oracle[key] = step + 1
+				} else {
+					if got := m.Delete(key); got != hasKey(oracle, key) {
+						t.Fatalf("step %d: Delete(%q) = %v, want %v", step, key, got, !got)
+					}
+					delete(oracle, key)
+				}
+				if r, ok := m.(*RadixTree[int]); ok {
+					if err := checkRadix(&r.root, true); err != nil {
+						t.Fatalf("step %d: %v", step, err)
+					}
+				}
+				compareWithMap(t, m, oracle, rng)
+			}
+		})
+	}
+}
+
+func TestMapsExamples(t *testing.T) {
+	for _, tt := range prefixMaps {
+		m := tt.make()
+		for i, word := range []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", ""} {
+			m.Insert(word, i+1)
+		}
+		var got []string
+		for key := range m.KeysWithPrefix("rub") {
+			got = append(got, key)
+		}
+		if !slices.Equal(got, []string{"rubens", "ruber", "rubicon", "rubicundus"}) {
+			t.Errorf("%s: KeysWithPrefix(rub) = %q", tt.name, got)
+		}
+		if key, _, _ := m.LongestPrefixOf("romanesque"); key != "romane" {
+			t.Errorf("%s: LongestPrefixOf(romanesque) = %q, want romane", tt.name, key)
+		}
+		// the empty key is a prefix of everything
+		if key, value, ok := m.LongestPrefixOf("xyz"); key != "" || value != 8 || !ok {
+			t.Errorf("%s: LongestPrefixOf(xyz) = %q, %d, %v; want the empty key", tt.name, key, value, ok)
+		}
+		var matched []string
+		for key := range m.Match("rube.s") {
+			matched = append(matched, key)
+		}
+		if !slices.Equal(matched, []string{"rubens"}) {
+			t.Errorf("%s: Match(rube.s) = %q, want [rubens]", tt.name, matched)
+		}
+		// iteration stops when the caller breaks out
+		count := 0
+		for range m.All() {
+			count++
+			if count == 3 {
+				break
+			}
+		}
+		if count != 3 {
+			t.Errorf("%s: All() kept yielding after break", tt.name)
+		}
+	}
+}
+
+var syllables = []string{
+	"an", "ber", "con", "de", "ex", "for", "gra", "in", "ly", "ment",
+	"ness", "o", "pre", "qui", "re", "sta", "tion", "un", "ver", "wo",
+}
+
+// wordList generates n distinct pseudo-words built from common syllables, so
+// that like natural language they share many prefixes and suffixes
+func wordList(n int, rng *rand.Rand) []string {
+	seen := make(map[string]bool, n)
+	words := make([]string, 0, n)
+	var b strings.Builder
+	for len(words) < n {
+		b.Reset()
+		for range 2 + rng.Intn(5) {
+			b.WriteString(syllables[rng.Intn(len(syllables))])
+		}
+		if word := b.String(); !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+var benchWords = wordList(50000, rand.New(rand.NewSource(1)))
+
+// benchmarkMap measures building the whole word list, looking every word
+// up, prefix queries and deleting every word; the insert step's B/op is the
+// memory the structure needs for the list
+func benchmarkMap(b *testing.B, newMap func() Map[int]) {
+	full := newMap()
+	for i, word := range benchWords {
+		full.Insert(word, i)
+	}
+	b.Run("insert", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			m := newMap()
+			for i, word := range benchWords {
+				m.Insert(word, i)
+			}
+		}
+	})
+	b.Run("get", func(b *testing.B) {
+		for i := range b.N {
+			if _, ok := full.Get(benchWords[i%len(benchWords)]); !ok {
+				b.Fatal("lost a word")
+			}
+		}
+	})
+	b.Run("prefix", func(b *testing.B) {
+		prefixes := []string{"pre", "conde", "stationun", "x"}
+		for i := range b.N {
+			for range full.KeysWithPrefix(prefixes[i%len(prefixes)]) {
+			}
+		}
+	})
+	b.Run("delete", func(b *testing.B) {
+		for range b.N {
+			b.StopTimer()
+			m := newMap()
+			for i, word := range benchWords {
+				m.Insert(word, i)
+			}
+			b.StartTimer()
+			for _, word := range benchWords {
+				m.Delete(word)
+			}
+		}
+	})
+}
+
+func BenchmarkTrie(b *testing.B) {
+	benchmarkMap(b, func() Map[int] { return &Trie[int]{} })
+}
+
+func BenchmarkRadix(b *testing.B) {
+	benchmarkMap(b, func() Map[int] { return &RadixTree[int]{} })
+}
+
+// BenchmarkBuiltinMap is the baseline for memory, inserts and lookups; a
+// built-in map cannot answer prefix queries
+func BenchmarkBuiltinMap(b *testing.B) {
+	full := map[string]int{}
+	for i, word := range benchWords {
+		full[word] = i
+	}
+	b.Run("insert", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			m := map[string]int{}
+			for i, word := range benchWords {
+				m[word] = i
+			}
+		}
+	})
+	b.Run("get", func(b *testing.B) {
+		for i := range b.N {
+			if _, ok := full[benchWords[i%len(benchWords)]]; !ok {
+				b.Fatal("lost a word")
+			}
+		}
+	})
+}
@@ -0,0 +1,31 @@
+package graph
+
+import "fmt"
+
+// MatrixGraph struct using adjacency matrix
+type MatrixGraph struct {
+	Matrix [][]int
+	Size   int
+}
+
+// NewMatrixGraph creates a new graph with a given size
+func NewMatrixGraph(size int) *MatrixGraph {
+	matrix := make([][]int, size)
+	for i := range matrix {
+		matrix[i] = make([]int, size)
+	}
+	return &MatrixGraph{Matrix: matrix, Size: size}
+}
+
+// AddEdge adds a new edge to the graph
+func (g *MatrixGraph) AddEdge(node1, node2 int) {
+	g.Matrix[node1][node2] = 1
+	g.Matrix[node2][node1] = 1
+}
+
+// Display prints the graph as an adjacency matrix
+func (g *MatrixGraph) Display() {
+	for _, row := range g.Matrix {
+		fmt.Println(row)
+	}
+}
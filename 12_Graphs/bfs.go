@@ -0,0 +1,31 @@
+package graph
+
+import (
+	"iter"
+
+	stackqueue "github.com/kuldeep-bishnoi/Golang-DSA/10_Stacks_Queues"
+)
+
+// BFS returns an iterator over the nodes reachable from start in breadth-first order
+func (g *Graph) BFS(start int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		visited := make(map[int]bool)
+		queue := &stackqueue.Queue[int]{}
+		queue.Enqueue(start)
+		visited[start] = true
+
+		for !queue.IsEmpty() {
+			node, _ := queue.Dequeue()
+			if !yield(node) {
+				return
+			}
+
+			for _, neighbor := range g.Nodes[node] {
+				if !visited[neighbor] {
+					visited[neighbor] = true
+					queue.Enqueue(neighbor)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package graph
+
+import "iter"
+
+// DFS returns an iterator over the nodes reachable from start in depth-first order
+func (g *Graph) DFS(start int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		g.dfs(start, make(map[int]bool), yield)
+	}
+}
+
+func (g *Graph) dfs(node int, visited map[int]bool, yield func(int) bool) bool {
+	if visited[node] {
+		return true
+	}
+	visited[node] = true
+	if !yield(node) {
+		return false
+	}
+	for _, neighbor := range g.Nodes[node] {
+		if !g.dfs(neighbor, visited, yield) {
+			return false
+		}
+	}
+	return true
+}
@@ -1,6 +1,11 @@
-package main
+package graph
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+)
 
 // Graph struct using adjacency list
 type Graph struct {
@@ -28,14 +33,7 @@ func (g *Graph) Display() {
 	}
 }
 
-func main() {
-	g := &Graph{}
-	g.AddNode(1)
-	g.AddNode(2)
-	g.AddNode(3)
-	g.AddEdge(1, 2)
-	g.AddEdge(1, 3)
-	g.AddEdge(2, 3)
-	fmt.Println("Graph Adjacency List:")
-	g.Display()
+// All returns an iterator over the nodes of the graph in ascending order
+func (g *Graph) All() iter.Seq[int] {
+	return slices.Values(slices.Sorted(maps.Keys(g.Nodes)))
 }
@@ -0,0 +1,71 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+	"testing"
+)
+
+// sampleGraph is a square 1-2-4-3 with a tail 4-5, an isolated node 6 and
+// a separate component 7-8
+func sampleGraph() *Graph {
+	g := &Graph{}
+	for node := 1; node <= 8; node++ {
+		g.AddNode(node)
+	}
+	for _, edge := range [][2]int{{1, 2}, {1, 3}, {2, 4}, {3, 4}, {4, 5}, {7, 8}} {
+		g.AddEdge(edge[0], edge[1])
+	}
+	return g
+}
+
+func TestTraversalOrder(t *testing.T) {
+	g := sampleGraph()
+	tests := []struct {
+		name string
+		seq  iter.Seq[int]
+		want []int
+	}{
+		{"All", g.All(), []int{1, 2, 3, 4, 5, 6, 7, 8}},
+		{"BFS(1)", g.BFS(1), []int{1, 2, 3, 4, 5}},
+		{"BFS(4)", g.BFS(4), []int{4, 2, 3, 5, 1}},
+		{"BFS(6)", g.BFS(6), []int{6}},
+		{"BFS(8)", g.BFS(8), []int{8, 7}},
+		{"DFS(1)", g.DFS(1), []int{1, 2, 4, 3, 5}},
+		{"DFS(5)", g.DFS(5), []int{5, 4, 2, 1, 3}},
+		{"DFS(6)", g.DFS(6), []int{6}},
+		{"DFS(7)", g.DFS(7), []int{7, 8}},
+	}
+	for _, tt := range tests {
+		if got := slices.Collect(tt.seq); !slices.Equal(got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+		}
+		// a second pass starts afresh rather than sharing the visited set
+		if got := slices.Collect(tt.seq); !slices.Equal(got, tt.want) {
+			t.Errorf("second pass of %s = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if got := slices.Collect((&Graph{}).All()); len(got) != 0 {
+		t.Errorf("All() of an empty graph = %v, want none", got)
+	}
+}
+
+// TestTraversalsStopEarly breaks out of each iterator; the range loop panics
+// if an iterator keeps calling yield after the body has stopped it
+func TestTraversalsStopEarly(t *testing.T) {
+	g := sampleGraph()
+	for name, seq := range map[string]iter.Seq[int]{
+		"All": g.All(), "BFS": g.BFS(1), "DFS": g.DFS(1),
+	} {
+		var got []int
+		for node := range seq {
+			got = append(got, node)
+			if len(got) == 2 {
+				break
+			}
+		}
+		if len(got) != 2 {
+			t.Errorf("%s yielded %v after a break at 2 nodes", name, got)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+
+	arrays "github.com/kuldeep-bishnoi/Golang-DSA/03_Arrays"
+)
+
+func main() {
+	// 1D arrays
+	arr := []int{1, 2, 3, 4, 5}
+	fmt.Print("Array Traversal: ")
+	arrays.TraverseArray(arr)
+	fmt.Println("Maximum Element:", arrays.FindMax(arr))
+	left, right := arrays.TwoSum(arr, 5)
+	fmt.Println("Two Sum Indices:", left, right)
+	fmt.Println("Max Sum Subarray of size 2:", arrays.MaxSumSubarray(arr, 2))
+
+	// 2D arrays
+	matrix := [][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	fmt.Println("2D Array Traversal:")
+	arrays.Traverse2DArray(matrix)
+
+	matrixA := [][]int{
+		{1, 2},
+		{3, 4},
+	}
+	matrixB := [][]int{
+		{5, 6},
+		{7, 8},
+	}
+	fmt.Println("Matrix Addition:")
+	result := arrays.AddMatrices(matrixA, matrixB)
+	arrays.Traverse2DArray(result)
+
+	// 3D arrays
+	cube := [][][]int{
+		{
+			{1, 2, 3},
+			{4, 5, 6},
+		},
+		{
+			{7, 8, 9},
+			{10, 11, 12},
+		},
+	}
+	fmt.Println("3D Array Traversal:")
+	arrays.Traverse3DArray(cube)
+}
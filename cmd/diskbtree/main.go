@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kuldeep-bishnoi/Golang-DSA/11_Trees/diskbtree"
+)
+
+func main() {
+	path := flag.String("file", "", "page file to use (default: a temporary file)")
+	flag.Parse()
+	if *path == "" {
+		dir, err := os.MkdirTemp("", "diskbtree")
+		if err != nil {
+			panic(err)
+		}
+		defer os.RemoveAll(dir)
+		*path = filepath.Join(dir, "fruits.db")
+	}
+
+	db, err := diskbtree.Open(*path, nil)
+	if err != nil {
+		panic(err)
+	}
+	for i, fruit := range []string{"banana", "apple", "cherry", "date", "elderberry", "fig"} {
+		if err := db.Put([]byte(fruit), []byte(fmt.Sprint(i+1))); err != nil {
+			panic(err)
+		}
+	}
+	if _, err := db.Delete([]byte("date")); err != nil {
+		panic(err)
+	}
+	db.Close()
+
+	// Every Put and Delete was committed, so reopening sees all of them
+	db, err = diskbtree.Open(*path, nil)
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+	value, _ := db.Get([]byte("cherry"))
+	fmt.Println("Reopened", *path, "with", db.Len(), "keys, cherry =", string(value))
+	fmt.Print("Range [b, e]: ")
+	db.Range([]byte("b"), []byte("e"), func(key, value []byte) bool {
+		fmt.Printf("%s=%s ", key, value)
+		return true
+	})
+	fmt.Println()
+}
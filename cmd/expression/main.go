@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/kuldeep-bishnoi/Golang-DSA/10_Stacks_Queues/expression"
+)
+
+func main() {
+	env := &expression.Env{}
+	env.SetVar("x", 3)
+	env.Register("sqrt", 1, func(args []float64) (float64, error) {
+		return math.Sqrt(args[0]), nil
+	})
+
+	input := "-x ^ 2 + sqrt(16) * (2 - 5)"
+	tokens, _ := expression.Tokenize(input)
+	postfix, _ := expression.ToPostfix(tokens)
+	var parts []string
+	for _, tok := range postfix {
+		if tok.Unary {
+			parts = append(parts, "neg")
+		} else {
+			parts = append(parts, tok.Text)
+		}
+	}
+	fmt.Println("Infix:", input)
+	fmt.Println("Postfix:", strings.Join(parts, " "))
+	result, _ := expression.EvalPostfix(postfix, env)
+	fmt.Println("Result:", result)
+
+	for _, bad := range []string{"2 * (3 + 4", "1 + * 2", "sqrt(1, 2)", "10 / (x - 3)"} {
+		_, err := expression.Evaluate(bad, env)
+		fmt.Println("Error:", err)
+	}
+}
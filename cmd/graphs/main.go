@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+
+	graph "github.com/kuldeep-bishnoi/Golang-DSA/12_Graphs"
+)
+
+func main() {
+	// Adjacency List
+	g := &graph.Graph{}
+	g.AddNode(1)
+	g.AddNode(2)
+	g.AddNode(3)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 3)
+	fmt.Println("Graph Adjacency List:")
+	g.Display()
+
+	// Adjacency Matrix
+	m := graph.NewMatrixGraph(3)
+	m.AddEdge(0, 1)
+	m.AddEdge(0, 2)
+	m.AddEdge(1, 2)
+	fmt.Println("Graph Adjacency Matrix:")
+	m.Display()
+
+	// Traversals
+	fmt.Println("BFS Traversal:")
+	for node := range g.BFS(1) {
+		fmt.Println(node)
+	}
+	fmt.Println("DFS Traversal:")
+	for node := range g.DFS(1) {
+		fmt.Println(node)
+	}
+}
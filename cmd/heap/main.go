@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+
+	heap "github.com/kuldeep-bishnoi/Golang-DSA/11_Trees/heap"
+)
+
+func main() {
+	// Min-heap built in O(n)
+	minHeap := heap.Heapify([]int{64, 25, 12, 22, 11}, func(a, b int) bool { return a < b })
+	minHeap.Push(5)
+	fmt.Print("Min-Heap Pop Order: ")
+	for !minHeap.IsEmpty() {
+		value, _ := minHeap.Pop()
+		fmt.Print(value, " ")
+	}
+	fmt.Println()
+
+	// Indexed heap used as a priority queue with decrease-key
+	type task struct {
+		name     string
+		priority int
+	}
+	tasks := heap.NewIndexed(func(a, b task) bool { return a.priority < b.priority })
+	tasks.Push(task{"write report", 3})
+	deploy := tasks.Push(task{"deploy", 5})
+	tasks.Push(task{"review PR", 2})
+	tasks.DecreaseKey(deploy, task{"deploy", 1})
+	fmt.Print("Task Order: ")
+	for !tasks.IsEmpty() {
+		t, _ := tasks.Pop()
+		fmt.Print(t.name, "; ")
+	}
+	fmt.Println()
+}
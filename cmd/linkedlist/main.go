@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+
+	list "github.com/kuldeep-bishnoi/Golang-DSA/09_Linked_Lists"
+)
+
+func main() {
+	// Singly Linked List
+	sll := &list.SinglyLinkedList[int]{}
+	sll.InsertAtEnd(1)
+	sll.InsertAtEnd(2)
+	sll.InsertAtEnd(3)
+	fmt.Print("Singly Linked List: ")
+	sll.Display()
+
+	// Doubly Linked List
+	dll := &list.DoublyLinkedList[int]{}
+	dll.InsertAtEnd(1)
+	dll.InsertAtEnd(2)
+	dll.InsertAtEnd(3)
+	fmt.Print("Doubly Linked List: ")
+	dll.Display()
+
+	// Circular Linked List
+	cll := &list.CircularLinkedList[int]{}
+	cll.InsertAtEnd(1)
+	cll.InsertAtEnd(2)
+	cll.InsertAtEnd(3)
+	fmt.Print("Circular Linked List: ")
+	cll.Display()
+
+	// Lists of any type
+	names := &list.SinglyLinkedList[string]{}
+	names.InsertAtEnd("alice")
+	names.InsertAtEnd("bob")
+	fmt.Print("String Linked List: ")
+	names.Display()
+	fmt.Println("Contains bob:", list.Contains(names, "bob"))
+	fmt.Println("Index of 3 in circular list:", list.IndexOf(cll, 3))
+	fmt.Println("Index of 4 in doubly list:", list.IndexOf(dll, 4))
+
+	// Iteration
+	fmt.Print("Doubly Linked List Backward: ")
+	for v := range dll.Backward() {
+		fmt.Print(v, " ")
+	}
+	fmt.Println()
+
+	// Mutations
+	sll.InsertAtHead(0)
+	sll.InsertAt(2, 9)
+	fmt.Print("After InsertAtHead(0) and InsertAt(2, 9): ")
+	sll.Display()
+	list.DeleteValue(sll, 9)
+	sll.DeleteAt(0)
+	sll.Reverse()
+	fmt.Println("After deletes and Reverse:", sll.ToSlice(), "Len:", sll.Len())
+	dll.Reverse()
+	fmt.Print("Reversed Doubly Linked List: ")
+	dll.Display()
+	cll.InsertAtHead(0)
+	cll.Reverse()
+	fmt.Print("Reversed Circular Linked List: ")
+	cll.Display()
+
+	// Algorithms
+	odds, evens := &list.SinglyLinkedList[int]{}, &list.SinglyLinkedList[int]{}
+	for i := 1; i <= 6; i++ {
+		if i%2 == 1 {
+			odds.InsertAtEnd(i)
+		} else {
+			evens.InsertAtEnd(i)
+		}
+	}
+	merged := list.FromHead(list.MergeSorted(odds.Head, evens.Head))
+	fmt.Print("Merged Sorted Lists: ")
+	merged.Display()
+	fmt.Println("Middle Node:", list.MiddleNode(merged.Head).Data)
+	merged = list.FromHead(list.RemoveNthFromEnd(merged.Head, 2))
+	fmt.Print("After Removing 2nd From End: ")
+	merged.Display()
+	fmt.Println("Is Palindrome:", list.IsPalindrome(merged.Head))
+	merged.Tail.Next = merged.Head.Next
+	fmt.Println("Cycle Starts At:", list.DetectCycle(merged.Head).Data)
+}
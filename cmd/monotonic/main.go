@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/kuldeep-bishnoi/Golang-DSA/10_Stacks_Queues/monotonic"
+)
+
+func main() {
+	arr := []int{4, 5, 2, 25, 7, 8}
+	fmt.Println("Array:", arr)
+	fmt.Println("Next Greater Index:", monotonic.NextGreater(arr))
+	fmt.Println("Previous Smaller Index:", monotonic.PrevSmaller(arr))
+
+	prices := []int{100, 80, 60, 70, 60, 75, 85}
+	fmt.Println("Stock Span of", prices, ":", monotonic.StockSpan(prices))
+
+	heights := []int{2, 1, 5, 6, 2, 3}
+	fmt.Println("Largest Rectangle in", heights, ":", monotonic.LargestRectangle(heights))
+
+	bars := []int{0, 1, 0, 2, 1, 0, 1, 3, 2, 1, 2, 1}
+	fmt.Println("Trapped Rain Water in", bars, ":", monotonic.TrapRainWater(bars))
+
+	matrix := [][]bool{
+		{true, false, true, false, false},
+		{true, false, true, true, true},
+		{true, true, true, true, true},
+		{true, false, false, true, false},
+	}
+	fmt.Println("Maximal Rectangle:", monotonic.MaximalRectangle(matrix))
+
+	nums := []int{1, 3, -1, -3, 5, 3, 6, 7}
+	fmt.Println("Sliding Window Max (k=3):", monotonic.SlidingWindowMax(nums, 3))
+	fmt.Println("Sliding Window Min (k=3):", monotonic.SlidingWindowMin(nums, 3))
+}
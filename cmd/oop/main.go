@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/kuldeep-bishnoi/Golang-DSA/08_OOP/abstraction"
+	"github.com/kuldeep-bishnoi/Golang-DSA/08_OOP/encapsulation"
+	"github.com/kuldeep-bishnoi/Golang-DSA/08_OOP/inheritance"
+	"github.com/kuldeep-bishnoi/Golang-DSA/08_OOP/polymorphism"
+)
+
+func main() {
+	// Abstraction
+	var v abstraction.Vehicle
+
+	v = abstraction.Car{Model: "Toyota"}
+	fmt.Println(v.Start())
+	fmt.Println(v.Stop())
+
+	v = abstraction.Bike{Model: "Yamaha"}
+	fmt.Println(v.Start())
+	fmt.Println(v.Stop())
+
+	// Encapsulation
+	account := encapsulation.NewBankAccount("123456789", 1000.0)
+	account.Deposit(500.0)
+	account.Withdraw(200.0)
+	fmt.Println("Current Balance:", account.GetBalance())
+
+	// Inheritance
+	emp := inheritance.Employee{
+		Person:     inheritance.Person{Name: "John Doe", Age: 30},
+		Department: "Engineering",
+	}
+	fmt.Println("Employee Name:", emp.Name)
+	fmt.Println("Employee Age:", emp.Age)
+	fmt.Println("Employee Department:", emp.Department)
+
+	// Polymorphism
+	var s polymorphism.Shape
+
+	s = polymorphism.Circle{Radius: 5}
+	fmt.Println("Circle Area:", s.Area())
+	fmt.Println("Circle Perimeter:", s.Perimeter())
+
+	s = polymorphism.Rectangle{Width: 4, Height: 6}
+	fmt.Println("Rectangle Area:", s.Area())
+	fmt.Println("Rectangle Perimeter:", s.Perimeter())
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"fmt"
+
+	recursion "github.com/kuldeep-bishnoi/Golang-DSA/07_Recursion_Backtracking"
+)
+
+func main() {
+	fmt.Println("Factorial of 5:", recursion.Factorial(5))
+	fmt.Println("Fibonacci of 5:", recursion.Fibonacci(5))
+	fmt.Println("N-Queens solutions for 4 queens:", recursion.SolveNQueens(4))
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+
+	search "github.com/kuldeep-bishnoi/Golang-DSA/04_Searching"
+)
+
+func main() {
+	arr := []int{1, 2, 3, 4, 5}
+	target := 3
+	fmt.Println("Linear Search Index of", target, ":", search.LinearSearch(arr, target))
+	fmt.Println("Binary Search Index of", target, ":", search.BinarySearch(arr, target))
+	fmt.Println("Interpolation Search Index:", search.InterpolationSearch(arr, target))
+	fmt.Println("Jump Search Index:", search.JumpSearch(arr, target))
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+
+	sorting "github.com/kuldeep-bishnoi/Golang-DSA/05_Sorting"
+)
+
+func main() {
+	arr := []int{64, 25, 12, 22, 11}
+	fmt.Println("Bubble Sorted:", sorting.BubbleSort(append([]int{}, arr...)))
+	fmt.Println("Selection Sorted:", sorting.SelectionSort(append([]int{}, arr...)))
+	fmt.Println("Insertion Sorted:", sorting.InsertionSort(append([]int{}, arr...)))
+	fmt.Println("Merge Sorted:", sorting.MergeSort(append([]int{}, arr...)))
+	fmt.Println("Quick Sorted:", sorting.QuickSort(append([]int{}, arr...)))
+	fmt.Println("Heap Sorted:", sorting.HeapSort(append([]int{}, arr...)))
+}
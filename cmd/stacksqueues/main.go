@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	stackqueue "github.com/kuldeep-bishnoi/Golang-DSA/10_Stacks_Queues"
+)
+
+func main() {
+	// Stack operations
+	stack := &stackqueue.Stack[int]{}
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	fmt.Println("Stack Items:", stack.Values())
+	top, _ := stack.Peek()
+	fmt.Println("Stack Peek:", top)
+	for !stack.IsEmpty() {
+		item, _ := stack.Pop()
+		fmt.Println("Stack Pop:", item)
+	}
+	if _, err := stack.Pop(); err != nil {
+		fmt.Println("Stack Pop:", err)
+	}
+
+	// Queue operations
+	queue := &stackqueue.Queue[string]{}
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+	fmt.Print("Queue Items: ")
+	for item := range queue.All() {
+		fmt.Print(item, " ")
+	}
+	fmt.Println()
+	front, _ := queue.Front()
+	fmt.Println("Queue Front:", front, "Len:", queue.Len())
+	for !queue.IsEmpty() {
+		item, _ := queue.Dequeue()
+		fmt.Println("Queue Dequeue:", item)
+	}
+	if _, err := queue.Dequeue(); err != nil {
+		fmt.Println("Queue Dequeue:", err)
+	}
+
+	// Deque operations
+	deque := &stackqueue.Deque[int]{}
+	deque.PushBack(2)
+	deque.PushBack(3)
+	deque.PushFront(1)
+	fmt.Println("Deque Items:", deque.Values())
+	second, _ := deque.At(1)
+	fmt.Println("Deque At(1):", second)
+	last, _ := deque.PopBack()
+	first, _ := deque.PopFront()
+	fmt.Println("Deque PopBack:", last, "PopFront:", first, "Len:", deque.Len())
+
+	// Blocking queue shared by a producer and a consumer
+	jobs := stackqueue.NewBlockingQueue[int](2)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			job, err := jobs.Take()
+			if err != nil {
+				fmt.Println("Consumer stopped:", err)
+				return
+			}
+			fmt.Println("Consumed job", job)
+		}
+	}()
+	for job := 1; job <= 3; job++ {
+		jobs.Put(job)
+	}
+	jobs.Close()
+	wg.Wait()
+
+	// Lock-free variants share the LIFO and FIFO interfaces with the plain types
+	var lifo stackqueue.LIFO[int] = &stackqueue.LockFreeStack[int]{}
+	var fifo stackqueue.FIFO[int] = stackqueue.NewLockFreeQueue[int]()
+	for i := 1; i <= 3; i++ {
+		lifo.Push(i)
+		fifo.Enqueue(i)
+	}
+	popped, _ := lifo.Pop()
+	dequeued, _ := fifo.Dequeue()
+	fmt.Println("Lock-free Stack Pop:", popped, "Lock-free Queue Dequeue:", dequeued)
+
+	// Stacks and queues built from each other
+	var viaStacks stackqueue.FIFO[string] = &stackqueue.QueueFromStacks[string]{}
+	var viaQueues stackqueue.LIFO[string] = &stackqueue.StackFromQueues[string]{}
+	for _, word := range []string{"first", "second", "third"} {
+		viaStacks.Enqueue(word)
+		viaQueues.Push(word)
+	}
+	oldest, _ := viaStacks.Dequeue()
+	newest, _ := viaQueues.Pop()
+	fmt.Println("QueueFromStacks Dequeue:", oldest, "StackFromQueues Pop:", newest)
+
+	// Min/max tracking in O(1)
+	minStack := &stackqueue.MinStack[int]{}
+	maxQueue := &stackqueue.MaxQueue[int]{}
+	for _, v := range []int{5, 2, 8, 1, 7} {
+		minStack.Push(v)
+		maxQueue.Enqueue(v)
+	}
+	minStack.Pop()
+	maxQueue.Dequeue()
+	lowest, _ := minStack.Min()
+	highest, _ := maxQueue.Max()
+	fmt.Println("MinStack Min after Pop:", lowest, "MaxQueue Max after Dequeue:", highest)
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+
+	strutil "github.com/kuldeep-bishnoi/Golang-DSA/06_Strings"
+)
+
+func main() {
+	// String Concatenation
+	str1 := "Hello"
+	str2 := "World"
+	concatenatedStr := str1 + " " + str2
+	fmt.Println("Concatenated String:", concatenatedStr)
+
+	// String Slicing
+	str := "Hello, World!"
+	fmt.Println("Sliced String:", str[0:5])
+
+	// String Reversal
+	reversedStr := strutil.ReverseString(str)
+	fmt.Println("Reversed String:", reversedStr)
+}
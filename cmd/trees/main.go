@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	tree "github.com/kuldeep-bishnoi/Golang-DSA/11_Trees"
+)
+
+func main() {
+	// Binary Tree
+	bt := &tree.BinaryTree{}
+	bt.Insert(5)
+	bt.Insert(3)
+	bt.Insert(7)
+	bt.Insert(2)
+	bt.Insert(4)
+	fmt.Print("Binary Tree In-Order Traversal: ")
+	bt.InOrderTraversal()
+
+	// Binary Search Tree
+	bst := &tree.BinarySearchTree{}
+	bst.Insert(5)
+	bst.Insert(3)
+	bst.Insert(7)
+	bst.Insert(2)
+	bst.Insert(4)
+	fmt.Println("Search 4 in BST:", bst.Search(4))
+	fmt.Println("Search 6 in BST:", bst.Search(6))
+
+	// Tree Traversals
+	bt = &tree.BinaryTree{}
+	bt.Root = &tree.Node{Value: 5, Left: &tree.Node{Value: 3, Left: &tree.Node{Value: 2}, Right: &tree.Node{Value: 4}}, Right: &tree.Node{Value: 7}}
+	fmt.Print("In-Order Traversal: ")
+	bt.InOrderTraversal()
+	fmt.Print("Pre-Order Traversal: ")
+	bt.PreOrderTraversal()
+	fmt.Print("Post-Order Traversal: ")
+	bt.PostOrderTraversal()
+	fmt.Print("Level-Order Traversal: ")
+	for value := range bt.LevelOrder() {
+		fmt.Print(value, " ")
+	}
+	fmt.Println()
+	fmt.Println("Morris In-Order:", bt.MorrisInOrder(), "Morris Post-Order:", bt.MorrisPostOrder())
+	fmt.Println("Zigzag:", bt.ZigzagLevelOrder(), "Reverse Levels:", bt.ReverseLevelOrder())
+	fmt.Println("Vertical:", bt.VerticalOrder(), "Boundary:", bt.BoundaryTraversal())
+
+	// Generic BST ordered map
+	ages := &tree.BST[string, int]{}
+	for name, age := range map[string]int{"mia": 31, "ana": 25, "zoe": 40, "leo": 19, "eve": 28} {
+		ages.Put(name, age)
+	}
+	ages.Delete("leo")
+	first, _ := ages.Min()
+	floor, _ := ages.Floor("m")
+	second, _ := ages.Select(1)
+	fmt.Println("BST Len:", ages.Len(), "Min:", first, "Floor(m):", floor, "Select(1):", second, "Rank(n):", ages.Rank("n"))
+	fmt.Print("BST Range [b, p]: ")
+	for name, age := range ages.Range("b", "p") {
+		fmt.Print(name, "=", age, " ")
+	}
+	fmt.Println()
+
+	// Self-balancing trees stay shallow on sorted input
+	avl := &tree.AVLTree[int, string]{}
+	redBlack := &tree.RedBlackTree[int, string]{}
+	for id := 1; id <= 1000; id++ {
+		avl.Put(id, fmt.Sprint("user", id))
+		redBlack.Put(id, fmt.Sprint("user", id))
+	}
+	name, _ := redBlack.Get(500)
+	fmt.Println("AVL height:", avl.Height(), "Red-black height:", redBlack.Height(), "Get(500):", name)
+	fmt.Println("AVL invariants:", avl.CheckInvariants(), "Red-black invariants:", redBlack.CheckInvariants())
+
+	// Wide-fanout B-tree and B+tree with range scans
+	index := tree.NewBTree[int, string](3)
+	leaves := tree.NewBPlusTree[int, string](3)
+	for id := 10; id <= 200; id += 10 {
+		index.Put(id, fmt.Sprint("row", id))
+		leaves.Put(id, fmt.Sprint("row", id))
+	}
+	index.Delete(50)
+	leaves.Delete(50)
+	fmt.Println("B-tree height:", index.Height(), "B+tree height:", leaves.Height(), "Len:", index.Len())
+	fmt.Print("B+tree Range [35, 85]: ")
+	for id, row := range leaves.Range(35, 85) {
+		fmt.Print(id, "=", row, " ")
+	}
+	fmt.Println()
+
+	// Alternative ordered maps behind the same interface
+	for _, scores := range []tree.OrderedMap[string, int]{
+		&tree.Treap[string, int]{},
+		tree.NewSkipList[string, int](0.25),
+		&tree.SplayTree[string, int]{},
+	} {
+		for i, player := range []string{"kai", "ana", "raj", "lin", "bo"} {
+			scores.Put(player, (i+1)*10)
+		}
+		scores.Delete("raj")
+		ceiling, _ := scores.Ceiling("c")
+		third, _ := scores.Select(2)
+		fmt.Printf("%T Len: %d Ceiling(c): %s Select(2): %s\n", scores, scores.Len(), ceiling, third)
+	}
+
+	// Building and serializing trees
+	rebuilt, err := tree.FromPreorderInorder([]int{5, 3, 2, 4, 7}, []int{2, 3, 4, 5, 7})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("Rebuilt from pre+in:", rebuilt.FormatLevelOrder(), rebuilt.FormatParens())
+	parsed, _ := tree.ParseLevelOrder("[1,2,null,3]")
+	encoded, _ := json.Marshal(parsed)
+	fmt.Println("Parsed [1,2,null,3] as JSON:", string(encoded))
+
+	// Rendering
+	fmt.Print("Top-down:\n", rebuilt.RenderTopDown())
+	fmt.Print("Sideways:\n", rebuilt.RenderSideways())
+
+	// Analytics
+	sample, _ := tree.ParseLevelOrder("[5,4,8,11,null,13,4,7,2,null,null,5,1]")
+	maxSum, _ := sample.MaxPathSum()
+	fmt.Println("Height:", sample.Height(), "Size:", sample.Size(), "Diameter:", sample.Diameter(),
+		"Balanced:", sample.IsBalanced(), "BST:", sample.IsBST(), "Symmetric:", sample.IsSymmetric())
+	fmt.Println("LCA(7, 2):", sample.LowestCommonAncestor(7, 2).Value, "Max path sum:", maxSum,
+		"Paths summing to 22:", sample.PathsWithSum(22))
+	third, _ := bst.KthSmallest(3)
+	fmt.Println("BST LCA(2, 4):", bst.LowestCommonAncestor(2, 4).Value, "3rd smallest:", third)
+}
@@ -0,0 +1,56 @@
+// Treeviz draws a binary tree given as a level-order array such as
+// "[1,2,null,3]" or in parenthesized form such as "1(2(3))", read from the
+// first argument or from standard input:
+//
+//	go run ./cmd/treeviz -format dot "[5,3,7,2,4]" | dot -Tpng > tree.png
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	tree "github.com/kuldeep-bishnoi/Golang-DSA/11_Trees"
+)
+
+func main() {
+	format := flag.String("format", "topdown", "output format: topdown, sideways or dot")
+	flag.Parse()
+
+	input := strings.Join(flag.Args(), " ")
+	if input == "" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		input = string(data)
+	}
+	input = strings.TrimSpace(input)
+
+	var bt *tree.BinaryTree
+	var err error
+	if strings.HasPrefix(input, "[") {
+		bt, err = tree.ParseLevelOrder(input)
+	} else {
+		bt, err = tree.ParseParens(input)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	switch *format {
+	case "topdown":
+		fmt.Print(bt.RenderTopDown())
+	case "sideways":
+		fmt.Print(bt.RenderSideways())
+	case "dot":
+		fmt.Print(bt.DOT())
+	default:
+		fmt.Fprintf(os.Stderr, "unknown format %q\n", *format)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/kuldeep-bishnoi/Golang-DSA/11_Trees/trie"
+)
+
+func main() {
+	for _, words := range []trie.Map[int]{&trie.Trie[int]{}, &trie.RadixTree[int]{}} {
+		for i, word := range []string{"tea", "ten", "team", "to", "inn", "in", "tent"} {
+			words.Insert(word, i)
+		}
+		words.Delete("ten")
+		fmt.Printf("%T Len: %d HasPrefix(te): %v HasPrefix(x): %v\n",
+			words, words.Len(), words.HasPrefix("te"), words.HasPrefix("x"))
+
+		fmt.Print("KeysWithPrefix(te): ")
+		for word, index := range words.KeysWithPrefix("te") {
+			fmt.Print(word, "=", index, " ")
+		}
+		fmt.Println()
+
+		longest, _, _ := words.LongestPrefixOf("teammate")
+		fmt.Print("LongestPrefixOf(teammate): ", longest, " Match(t..): ")
+		for word := range words.Match("t..") {
+			fmt.Print(word, " ")
+		}
+		fmt.Println()
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/kuldeep-bishnoi/Golang-DSA
+
+go 1.23